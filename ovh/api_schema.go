@@ -0,0 +1,294 @@
+package ovh
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+// apiSchema is a description document of the OVH API, like
+// https://eu.api.ovh.com/1.0/vps.json. It describes the paths of an API
+// (e.g. /vps and its sub paths), and the models of their responses.
+type apiSchema struct {
+	APIVersion   string                    `json:"apiVersion"`
+	ResourcePath string                    `json:"resourcePath"`
+	APIs         []apiSchemaPath           `json:"apis"`
+	Models       map[string]apiSchemaModel `json:"models"`
+}
+
+type apiSchemaPath struct {
+	Path        string               `json:"path"`
+	Description string               `json:"description"`
+	Operations  []apiSchemaOperation `json:"operations"`
+}
+
+type apiSchemaOperation struct {
+	HTTPMethod       string               `json:"httpMethod"`
+	Description      string               `json:"description"`
+	APIStatus        apiSchemaStatus      `json:"apiStatus"`
+	Parameters       []apiSchemaParameter `json:"parameters"`
+	ResponseType     string               `json:"responseType"`
+	NoAuthentication bool                 `json:"noAuthentication"`
+	IAMActions       []apiSchemaIAMAction `json:"iamActions"`
+}
+
+type apiSchemaStatus struct {
+	Value       string `json:"value"`
+	Description string `json:"description"`
+}
+
+type apiSchemaParameter struct {
+	Name        string   `json:"name"`
+	DataType    string   `json:"dataType"`
+	FullType    string   `json:"fullType"`
+	ParamType   string   `json:"paramType"`
+	Description string   `json:"description"`
+	Required    flexBool `json:"required"`
+}
+
+type apiSchemaIAMAction struct {
+	Name     string   `json:"name"`
+	Required flexBool `json:"required"`
+}
+
+type apiSchemaModel struct {
+	ID          string                       `json:"id"`
+	Namespace   string                       `json:"namespace"`
+	Description string                       `json:"description"`
+	Enum        []string                     `json:"enum"`
+	Properties  map[string]apiSchemaProperty `json:"properties"`
+}
+
+type apiSchemaProperty struct {
+	Type        string   `json:"type"`
+	FullType    string   `json:"fullType"`
+	Description string   `json:"description"`
+	CanBeNull   flexBool `json:"canBeNull"`
+	ReadOnly    flexBool `json:"readOnly"`
+}
+
+// flexBool is a boolean of the description documents, written as true or
+// false by the recent ones and as 1 or 0 by the older ones.
+type flexBool bool
+
+func (b *flexBool) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case "true", "1":
+		*b = true
+	case "false", "0", "null":
+		*b = false
+	default:
+		return fmt.Errorf("invalid boolean %s", data)
+	}
+	return nil
+}
+
+// operation returns the operation of a path with the given HTTP method.
+func (s *apiSchema) operation(path, method string) *apiSchemaOperation {
+	for i := range s.APIs {
+		if s.APIs[i].Path != path {
+			continue
+		}
+		for j := range s.APIs[i].Operations {
+			if s.APIs[i].Operations[j].HTTPMethod == method {
+				return &s.APIs[i].Operations[j]
+			}
+		}
+	}
+	return nil
+}
+
+// apiSchemaLoader loads the description documents of the API, from the
+// endpoint or from a local directory mirroring the paths of the documents
+// (e.g. <dir>/1.0/vps.json and <dir>/v2/iam.json).
+type apiSchemaLoader struct {
+	baseURL string
+	dir     string
+	client  *http.Client
+
+	mu      sync.Mutex
+	schemas map[string]*apiSchema
+}
+
+func newAPISchemaLoader(config ovhConfig) (*apiSchemaLoader, error) {
+	config, err := resolveConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := &apiSchemaLoader{schemas: map[string]*apiSchema{}}
+	if config.APISchemaDir != nil {
+		loader.dir = *config.APISchemaDir
+		return loader, nil
+	}
+
+	if !isSet(config.Endpoint) {
+		return nil, errors.New("'endpoint' or 'api_schema_dir' must be set in the connection configuration to load the description of the API. Edit your connection configuration file and then restart Steampipe")
+	}
+	loader.baseURL = apiBaseURL(*config.Endpoint)
+
+	proxyUrl := ""
+	caBundle := ""
+	if config.ProxyURL != nil {
+		proxyUrl = *config.ProxyURL
+	}
+	if config.CABundle != nil {
+		caBundle = *config.CABundle
+	}
+	loader.client, err = newHTTPClient(proxyUrl, caBundle)
+	if err != nil {
+		return nil, err
+	}
+	loader.client.Timeout = time.Minute
+	if config.Timeout != nil && *config.Timeout > 0 {
+		loader.client.Timeout = time.Duration(*config.Timeout) * time.Second
+	}
+	return loader, nil
+}
+
+// apiBaseURL returns the URL of an endpoint without its version, e.g.
+// https://eu.api.ovh.com for ovh-eu.
+func apiBaseURL(endpoint string) string {
+	if u, ok := ovh.Endpoints[endpoint]; ok {
+		endpoint = u
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	return strings.TrimSuffix(endpoint, "/1.0")
+}
+
+// splitAPIPath returns the version of the API of a path, and the path in
+// this version: /v2/iam/resource is /iam/resource of v2.
+func splitAPIPath(path string) (string, string) {
+	if strings.HasPrefix(path, "/v2/") {
+		return "v2", strings.TrimPrefix(path, "/v2")
+	}
+	return "1.0", path
+}
+
+// schemaOf returns the description document of a path. The document is
+// named after the root of an API, which can have several segments (e.g.
+// /dedicated/nasha), so each parent of the path is tried.
+func (l *apiSchemaLoader) schemaOf(path string) (*apiSchema, error) {
+	version, resource := splitAPIPath(path)
+	segments := strings.Split(strings.Trim(resource, "/"), "/")
+	for i := len(segments); i > 0; i-- {
+		if strings.HasPrefix(segments[i-1], "{") {
+			continue
+		}
+		schema, err := l.load("/" + version + "/" + strings.Join(segments[:i], "/"))
+		if err != nil {
+			return nil, err
+		}
+		if schema != nil {
+			return schema, nil
+		}
+	}
+	return nil, fmt.Errorf("no description of the API found for %s", path)
+}
+
+// apis returns the roots of the APIs of a version, e.g. /vps and
+// /dedicated/server for 1.0, listed by the index of the endpoint or by the
+// files of the local directory.
+func (l *apiSchemaLoader) apis(version string) ([]string, error) {
+	var apis []string
+	if l.dir != "" {
+		root := filepath.Join(l.dir, version)
+		err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() || filepath.Ext(path) != ".json" {
+				return err
+			}
+			rel, err := filepath.Rel(root, strings.TrimSuffix(path, ".json"))
+			if err != nil {
+				return err
+			}
+			apis = append(apis, "/"+filepath.ToSlash(rel))
+			return nil
+		})
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return apis, err
+	}
+
+	body, err := l.fetch("/" + version + "/")
+	if err != nil || body == nil {
+		return nil, err
+	}
+	var index struct {
+		APIs []struct {
+			Path string `json:"path"`
+		} `json:"apis"`
+	}
+	if err := json.Unmarshal(body, &index); err != nil {
+		return nil, fmt.Errorf("invalid index of the API %s: %w", version, err)
+	}
+	for _, api := range index.APIs {
+		apis = append(apis, api.Path)
+	}
+	return apis, nil
+}
+
+// load returns the description document at path (e.g. /1.0/vps), or nil if
+// it does not exist.
+func (l *apiSchemaLoader) load(path string) (*apiSchema, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if schema, ok := l.schemas[path]; ok {
+		return schema, nil
+	}
+
+	var body []byte
+	var err error
+	if l.dir != "" {
+		body, err = os.ReadFile(filepath.Join(l.dir, filepath.FromSlash(path)+".json"))
+		if os.IsNotExist(err) {
+			l.schemas[path] = nil
+			return nil, nil
+		}
+	} else {
+		body, err = l.fetch(path + ".json")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		l.schemas[path] = nil
+		return nil, nil
+	}
+
+	var schema apiSchema
+	if err := json.Unmarshal(body, &schema); err != nil {
+		return nil, fmt.Errorf("invalid description of the API %s: %w", path, err)
+	}
+	l.schemas[path] = &schema
+	return &schema, nil
+}
+
+// fetch returns the document at path of the endpoint, or nil if it does not
+// exist. The description of the API is public, the requests are not signed.
+func (l *apiSchemaLoader) fetch(path string) ([]byte, error) {
+	resp, err := l.client.Get(l.baseURL + path)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot get the description of the API %s: %s", path, resp.Status)
+	}
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,613 @@
+package ovh
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/turbot/steampipe-plugin-sdk/v6/connection"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/context_key"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/quals"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// Credentials expected by the fake API.
+const (
+	testApplicationKey    = "CitIbyantOosuzFu"
+	testApplicationSecret = "phoagDakOywytMibfetJidloidvuenVo"
+	testConsumerKey       = "einbycsAnmachCeOkvabicdifAdofdon"
+)
+
+// fakeAPI emulates the OVH API: it checks the signature of the requests and
+// answers with the fixtures of testdata/api, the file of a request being its
+// path with a .json extension (e.g. testdata/api/1.0/cloud/project.json).
+// The descriptions of the API (e.g. /1.0/vps.json) are public, they are
+// answered without checking the signature with the files of testdata/schema.
+type fakeAPI struct {
+	*httptest.Server
+	t *testing.T
+
+	// Maximum size of the pages of the v2 API, 0 for no maximum.
+	pageSize int
+
+	mu       sync.Mutex
+	requests []string
+	headers  []http.Header
+	failures map[string][]fakeFailure
+}
+
+// fakeFailure is an error answered instead of the fixture of a path.
+type fakeFailure struct {
+	code       int
+	retryAfter string
+}
+
+func newFakeAPI(t *testing.T) *fakeAPI {
+	api := &fakeAPI{t: t}
+	api.Server = httptest.NewServer(http.HandlerFunc(api.serveHTTP))
+	t.Cleanup(api.Close)
+	return api
+}
+
+// Endpoint returns the endpoint to use in the connection config.
+func (api *fakeAPI) Endpoint() string {
+	return api.URL + "/1.0"
+}
+
+// Fail answers the next requests of a path with the given errors, one per
+// request, before answering with the fixture of the path.
+func (api *fakeAPI) Fail(path string, failures ...fakeFailure) {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	if api.failures == nil {
+		api.failures = map[string][]fakeFailure{}
+	}
+	api.failures[path] = append(api.failures[path], failures...)
+}
+
+// Headers returns the headers of the requests received, except /auth/time.
+func (api *fakeAPI) Headers() []http.Header {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]http.Header(nil), api.headers...)
+}
+
+// Requests returns the request URIs received, except /auth/time.
+func (api *fakeAPI) Requests() []string {
+	api.mu.Lock()
+	defer api.mu.Unlock()
+	return append([]string(nil), api.requests...)
+}
+
+func (api *fakeAPI) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path == "/1.0/auth/time" {
+		fmt.Fprint(w, time.Now().Unix())
+		return
+	}
+
+	api.mu.Lock()
+	api.requests = append(api.requests, r.URL.RequestURI())
+	api.headers = append(api.headers, r.Header.Clone())
+	var failure *fakeFailure
+	if failures := api.failures[r.URL.Path]; len(failures) > 0 {
+		failure = &failures[0]
+		api.failures[r.URL.Path] = failures[1:]
+	}
+	api.mu.Unlock()
+
+	if strings.HasSuffix(r.URL.Path, ".json") {
+		http.ServeFile(w, r, filepath.Join("testdata", "schema", filepath.FromSlash(r.URL.Path)))
+		return
+	}
+	if r.URL.Path == "/1.0/" || r.URL.Path == "/v2/" {
+		api.serveIndex(w, r.URL.Path)
+		return
+	}
+
+	if err := api.checkSignature(r); err != nil {
+		writeAPIError(w, http.StatusForbidden, "Client::Forbidden", err.Error())
+		return
+	}
+	if failure != nil {
+		if failure.retryAfter != "" {
+			w.Header().Set("Retry-After", failure.retryAfter)
+		}
+		writeAPIError(w, failure.code, "Server::Error", http.StatusText(failure.code))
+		return
+	}
+
+	code, body, err := readFixture(r.URL.Path)
+	if os.IsNotExist(err) {
+		writeAPIError(w, http.StatusNotFound, "Client::NotFound", fmt.Sprintf("The requested object (%s) does not exist", r.URL.Path))
+		return
+	}
+	if err != nil {
+		api.t.Errorf("cannot read fixture of %s: %s", r.URL.Path, err)
+		writeAPIError(w, http.StatusInternalServerError, "Server::InternalServerError", err.Error())
+		return
+	}
+	if code == http.StatusOK && strings.HasPrefix(r.URL.Path, "/v2/") {
+		body, err = api.page(w, r, body)
+		if err != nil {
+			writeAPIError(w, http.StatusBadRequest, "Client::BadRequest", err.Error())
+			return
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+// page returns the page of a list of the v2 API requested by the
+// X-Pagination-Size and X-Pagination-Cursor headers, the cursor being the
+// offset of the page. The lists are returned in one page without them.
+func (api *fakeAPI) page(w http.ResponseWriter, r *http.Request, body []byte) ([]byte, error) {
+	var elements []json.RawMessage
+	if json.Unmarshal(body, &elements) != nil {
+		return body, nil
+	}
+
+	offset := 0
+	if cursor := r.Header.Get("X-Pagination-Cursor"); cursor != "" {
+		var err error
+		if offset, err = strconv.Atoi(cursor); err != nil || offset > len(elements) {
+			return nil, fmt.Errorf("invalid cursor %s", cursor)
+		}
+	}
+	size := len(elements)
+	if header := r.Header.Get("X-Pagination-Size"); header != "" {
+		var err error
+		if size, err = strconv.Atoi(header); err != nil || size <= 0 {
+			return nil, fmt.Errorf("invalid page size %s", header)
+		}
+	}
+	if api.pageSize > 0 && size > api.pageSize {
+		size = api.pageSize
+	}
+
+	end := min(offset+size, len(elements))
+	if end < len(elements) {
+		w.Header().Set("X-Pagination-Cursor-Next", strconv.Itoa(end))
+	}
+	return json.Marshal(elements[offset:end])
+}
+
+// serveIndex answers with the index of the descriptions of a version of the
+// API, listing the files of testdata/schema.
+func (api *fakeAPI) serveIndex(w http.ResponseWriter, path string) {
+	type indexAPI struct {
+		Path   string `json:"path"`
+		Schema string `json:"schema"`
+	}
+	index := struct {
+		APIs []indexAPI `json:"apis"`
+	}{}
+	root := filepath.Join("testdata", "schema", filepath.FromSlash(path))
+	matches, _ := filepath.Glob(filepath.Join(root, "*.json"))
+	for _, match := range matches {
+		name := "/" + strings.TrimSuffix(filepath.Base(match), ".json")
+		index.APIs = append(index.APIs, indexAPI{Path: name, Schema: name + ".{format}"})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(index)
+}
+
+// readFixture returns the fixture of a path, and the status code to answer
+// with. The fixtures of errors have the status code before their extension
+// (e.g. testdata/api/1.0/dedicated/server/name.460.json). The colons of the
+// paths (e.g. of the timestamps) are replaced by dashes in the file names,
+// as Windows does not allow them.
+func readFixture(path string) (int, []byte, error) {
+	name := filepath.Join("testdata", "api", filepath.FromSlash(strings.ReplaceAll(path, ":", "-")))
+	body, err := os.ReadFile(name + ".json")
+	if !os.IsNotExist(err) {
+		return http.StatusOK, body, err
+	}
+	matches, _ := filepath.Glob(name + ".[0-9][0-9][0-9].json")
+	if len(matches) == 0 {
+		return 0, nil, err
+	}
+	code, _ := strconv.Atoi(filepath.Ext(strings.TrimSuffix(matches[0], ".json"))[1:])
+	body, err = os.ReadFile(matches[0])
+	return code, body, err
+}
+
+func (api *fakeAPI) checkSignature(r *http.Request) error {
+	if r.Header.Get("X-Ovh-Application") != testApplicationKey {
+		return fmt.Errorf("invalid application key")
+	}
+	if r.Header.Get("X-Ovh-Consumer") != testConsumerKey {
+		return fmt.Errorf("invalid consumer key")
+	}
+	timestamp, err := strconv.ParseInt(r.Header.Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp")
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	h := sha1.New()
+	fmt.Fprintf(h, "%s+%s+%s+%s+%s+%d", testApplicationSecret, testConsumerKey, r.Method, api.URL+r.URL.RequestURI(), body, timestamp)
+	if r.Header.Get("X-Ovh-Signature") != fmt.Sprintf("$1$%x", h.Sum(nil)) {
+		return fmt.Errorf("invalid signature")
+	}
+	return nil
+}
+
+func writeAPIError(w http.ResponseWriter, code int, class, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(map[string]string{"class": class, "message": message})
+}
+
+// testQuery runs the hydrate functions of a table against the fake API.
+type testQuery struct {
+	t     *testing.T
+	api   *fakeAPI
+	table *plugin.Table
+	quals map[string]interface{}
+	limit int64
+
+	applicationKey string
+	maxRetries     int
+
+	// Stream of the last call of the query.
+	stream *testStream
+}
+
+func newTestQuery(t *testing.T, api *fakeAPI, tableName string, equals map[string]interface{}) *testQuery {
+	ctx := context.Background()
+	table, ok := staticTables(ctx)[tableName]
+	if !ok {
+		t.Fatalf("unknown table %s", tableName)
+	}
+	return newTableQuery(t, api, table, equals)
+}
+
+// newTableQuery returns a query on a table which is not one of the plugin,
+// like the dynamic tables.
+func newTableQuery(t *testing.T, api *fakeAPI, table *plugin.Table, equals map[string]interface{}) *testQuery {
+	return &testQuery{
+		t:              t,
+		api:            api,
+		table:          table,
+		quals:          equals,
+		limit:          math.MaxInt32,
+		applicationKey: testApplicationKey,
+	}
+}
+
+func testContext() context.Context {
+	return context.WithValue(context.Background(), context_key.Logger, hclog.NewNullLogger())
+}
+
+// queryData returns the query data of a query on the fake API, streaming
+// the listed items to items.
+func (q *testQuery) queryData(items *[]interface{}) *plugin.QueryData {
+	endpoint := q.api.Endpoint()
+	applicationKey := q.applicationKey
+	applicationSecret := testApplicationSecret
+	consumerKey := testConsumerKey
+	maxRetries := q.maxRetries
+	conn := &plugin.Connection{Name: q.t.Name()}
+	conn.SetConfig(ovhConfig{
+		Endpoint:          &endpoint,
+		ApplicationKey:    &applicationKey,
+		ApplicationSecret: &applicationSecret,
+		ConsumerKey:       &consumerKey,
+		MaxRetries:        &maxRetries,
+	})
+
+	cache, err := connection.NewConnectionCache(conn.Name, 1<<20)
+	if err != nil {
+		q.t.Fatal(err)
+	}
+
+	d := &plugin.QueryData{
+		Table:             q.table,
+		Connection:        conn,
+		ConnectionManager: connection.NewManager(cache),
+		ConnectionCache:   cache,
+		EqualsQuals:       make(plugin.KeyColumnEqualsQualMap),
+		Quals:             make(plugin.KeyColumnQualMap),
+	}
+	for column, value := range q.quals {
+		operatorQuals, ok := value.([]operatorQual)
+		if !ok {
+			operatorQuals = []operatorQual{{quals.QualOperatorEqual, value}}
+			if q, ok := value.(operatorQual); ok {
+				operatorQuals = []operatorQual{q}
+			}
+		}
+		d.Quals[column] = &plugin.KeyColumnQuals{Name: column}
+		for _, q := range operatorQuals {
+			qual := &quals.Qual{Column: column, Operator: q.operator, Value: qualValue(q.value)}
+			if q.operator == quals.QualOperatorEqual {
+				d.EqualsQuals[column] = qual.Value
+			}
+			d.Quals[column].Quals = append(d.Quals[column].Quals, qual)
+		}
+	}
+
+	stream := &testStream{limit: q.limit}
+	q.stream = stream
+
+	var mu sync.Mutex
+	d.StreamListItem = func(_ context.Context, streamed ...interface{}) {
+		mu.Lock()
+		defer mu.Unlock()
+		*items = append(*items, streamed...)
+		stream.streamed.Add(int64(len(streamed)))
+	}
+	return d
+}
+
+// testStream counts the rows streamed by a query, to give the rows remaining
+// like the SDK does with the limit of the query, and the waits for its rate
+// limiters. It gives the limits of the query, see withQueryLimits.
+type testStream struct {
+	limit    int64
+	streamed atomic.Int64
+	waits    atomic.Int64
+}
+
+func (s *testStream) RowsRemaining(ctx context.Context) int64 {
+	if ctx.Err() != nil {
+		return 0
+	}
+	return s.limit - s.streamed.Load()
+}
+
+func (s *testStream) WaitForListRateLimit(context.Context) {
+	s.waits.Add(1)
+}
+
+// context returns the context of the calls of the query, with its limits.
+func (q *testQuery) context() context.Context {
+	return withQueryLimits(testContext(), q.stream)
+}
+
+// jsonValue is the value of a qual on a JSON column.
+type jsonValue string
+
+// cidrValue is the value of a qual on a CIDR column.
+type cidrValue string
+
+// operatorQual is a qual with another operator than =, several quals on a
+// column being given as a []operatorQual.
+type operatorQual struct {
+	operator string
+	value    interface{}
+}
+
+func qualValue(value interface{}) *proto.QualValue {
+	switch v := value.(type) {
+	case string:
+		return &proto.QualValue{Value: &proto.QualValue_StringValue{StringValue: v}}
+	case jsonValue:
+		return &proto.QualValue{Value: &proto.QualValue_JsonbValue{JsonbValue: string(v)}}
+	case cidrValue:
+		return &proto.QualValue{Value: &proto.QualValue_InetValue{InetValue: &proto.Inet{Cidr: string(v)}}}
+	case int:
+		return &proto.QualValue{Value: &proto.QualValue_Int64Value{Int64Value: int64(v)}}
+	case time.Time:
+		return &proto.QualValue{Value: &proto.QualValue_TimestampValue{TimestampValue: timestamppb.New(v)}}
+	case []string:
+		list := &proto.QualValueList{}
+		for _, s := range v {
+			list.Values = append(list.Values, qualValue(s))
+		}
+		return &proto.QualValue{Value: &proto.QualValue_ListValue{ListValue: list}}
+	}
+	panic(fmt.Sprintf("unsupported qual value %#v", value))
+}
+
+// Limit sets the limit of the query.
+func (q *testQuery) Limit(limit int64) *testQuery {
+	q.limit = limit
+	return q
+}
+
+// Waits returns the number of waits for the rate limiters of the last call
+// of the query.
+func (q *testQuery) Waits() int64 {
+	return q.stream.waits.Load()
+}
+
+// MaxRetries sets the max_retries of the connection, 0 by default.
+func (q *testQuery) MaxRetries(maxRetries int) *testQuery {
+	q.maxRetries = maxRetries
+	return q
+}
+
+// List calls the list hydrate function of the table and returns the rows.
+func (q *testQuery) List() ([]map[string]interface{}, error) {
+	var items []interface{}
+	d := q.queryData(&items)
+	if _, err := q.table.List.Hydrate(q.context(), d, &plugin.HydrateData{}); err != nil {
+		return nil, err
+	}
+	return q.rows(d, items)
+}
+
+// Get calls the get hydrate function of the table and returns the row, or
+// nil when there is none.
+func (q *testQuery) Get() (map[string]interface{}, error) {
+	var items []interface{}
+	d := q.queryData(&items)
+	h := &plugin.HydrateData{}
+	item, err := q.table.Get.Hydrate(q.context(), d, h)
+	if err != nil && !shouldIgnore(q.table.Get.IgnoreConfig, d, h, err) {
+		return nil, err
+	}
+	if item == nil {
+		return nil, nil
+	}
+	rows, err := q.rows(d, []interface{}{item})
+	if err != nil {
+		return nil, err
+	}
+	return rows[0], nil
+}
+
+// rows builds the rows of the items, calling the hydrate functions and the
+// transforms of every column. Values are normalized through JSON to be
+// compared with the expected rows.
+func (q *testQuery) rows(d *plugin.QueryData, items []interface{}) ([]map[string]interface{}, error) {
+	ctx := q.context()
+	defaultTransform := q.table.DefaultTransform
+	if defaultTransform == nil {
+		defaultTransform = Plugin(ctx).DefaultTransform
+	}
+
+	var rows []map[string]interface{}
+	for _, item := range items {
+		hydrated := map[uintptr]interface{}{}
+		row := map[string]interface{}{}
+		for _, column := range q.table.Columns {
+			data := item
+			if column.Hydrate != nil {
+				result, err := q.hydrate(ctx, d, item, hydrated, column.Hydrate)
+				if err != nil {
+					return nil, fmt.Errorf("column %s: %w", column.Name, err)
+				}
+				data = result
+			}
+			// Like the SDK, leave the columns of a nil hydrate item null.
+			if data == nil || reflect.ValueOf(data).Kind() == reflect.Pointer && reflect.ValueOf(data).IsNil() {
+				row[column.Name] = nil
+				continue
+			}
+			columnTransform := column.Transform
+			if columnTransform == nil {
+				columnTransform = defaultTransform
+			}
+			value, err := columnTransform.Execute(ctx, &transform.TransformData{
+				HydrateItem:    data,
+				ColumnName:     column.Name,
+				KeyColumnQuals: d.Quals.ToQualMap(),
+			})
+			if err != nil {
+				return nil, fmt.Errorf("column %s: %w", column.Name, err)
+			}
+			// Like the SDK, take the strings of JSON columns as raw JSON.
+			if raw, ok := value.(string); ok && column.Type == proto.ColumnType_JSON {
+				if err := json.Unmarshal([]byte(raw), &value); err != nil {
+					return nil, fmt.Errorf("column %s: invalid JSON %q: %w", column.Name, raw, err)
+				}
+			}
+			row[column.Name] = normalize(value)
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+// hydrate calls a hydrate function once per item, after the functions it
+// depends on. Like the SDK, their results are given by their short name.
+func (q *testQuery) hydrate(ctx context.Context, d *plugin.QueryData, item interface{}, hydrated map[uintptr]interface{}, hydrateFunc plugin.HydrateFunc) (interface{}, error) {
+	key := reflect.ValueOf(hydrateFunc).Pointer()
+	if result, ok := hydrated[key]; ok {
+		return result, nil
+	}
+	config := q.hydrateConfig(key)
+	h := &plugin.HydrateData{Item: item, HydrateResults: map[string]interface{}{}}
+	for _, depend := range config.Depends {
+		result, err := q.hydrate(ctx, d, item, hydrated, depend)
+		if err != nil {
+			return nil, err
+		}
+		h.HydrateResults[hydrateFuncName(depend)] = result
+	}
+	result, err := hydrateFunc(ctx, d, h)
+	if err != nil && !shouldIgnore(config.IgnoreConfig, d, h, err) {
+		return nil, err
+	}
+	hydrated[key] = result
+	return result, nil
+}
+
+// hydrateConfig returns the config of a hydrate function.
+func (q *testQuery) hydrateConfig(hydrate uintptr) plugin.HydrateConfig {
+	for _, config := range q.table.HydrateConfig {
+		if reflect.ValueOf(config.Func).Pointer() == hydrate {
+			return config
+		}
+	}
+	return plugin.HydrateConfig{}
+}
+
+func hydrateFuncName(hydrateFunc plugin.HydrateFunc) string {
+	name := runtime.FuncForPC(reflect.ValueOf(hydrateFunc).Pointer()).Name()
+	return name[strings.LastIndex(name, ".")+1:]
+}
+
+func shouldIgnore(config *plugin.IgnoreConfig, d *plugin.QueryData, h *plugin.HydrateData, err error) bool {
+	return config != nil && config.ShouldIgnoreErrorFunc != nil && config.ShouldIgnoreErrorFunc(testContext(), d, h, err)
+}
+
+func normalize(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	var normalized interface{}
+	json.Unmarshal(b, &normalized)
+	return normalized
+}
+
+// assertRows checks that rows match the expected ones, only comparing the
+// columns present in the expected rows. Rows are sorted by the key column
+// as projects are listed concurrently.
+func assertRows(t *testing.T, rows []map[string]interface{}, key string, expected []map[string]interface{}) {
+	t.Helper()
+	if len(rows) != len(expected) {
+		t.Fatalf("got %d rows, expected %d: %v", len(rows), len(expected), rows)
+	}
+	sort.SliceStable(rows, func(i, j int) bool {
+		return fmt.Sprint(rows[i][key]) < fmt.Sprint(rows[j][key])
+	})
+	sort.SliceStable(expected, func(i, j int) bool {
+		return fmt.Sprint(normalize(expected[i][key])) < fmt.Sprint(normalize(expected[j][key]))
+	})
+	for i := range expected {
+		assertRow(t, rows[i], expected[i])
+	}
+}
+
+func assertRow(t *testing.T, row map[string]interface{}, expected map[string]interface{}) {
+	t.Helper()
+	for column, value := range expected {
+		if _, ok := row[column]; !ok {
+			t.Errorf("column %s does not exist", column)
+			continue
+		}
+		if !reflect.DeepEqual(row[column], normalize(value)) {
+			t.Errorf("column %s = %#v, expected %#v", column, row[column], normalize(value))
+		}
+	}
+}
@@ -0,0 +1,54 @@
+package ovh
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// titleColumn returns the title column of a table, read from a field of the
+// result of the hydrate function (or of the listed item when nil).
+func titleColumn(hydrate plugin.HydrateFunc, field string) *plugin.Column {
+	return &plugin.Column{
+		Name:        "title",
+		Type:        proto.ColumnType_STRING,
+		Description: "Title of the resource.",
+		Hydrate:     hydrate,
+		Transform:   transform.FromField(field).NullIfZero(),
+	}
+}
+
+// akasColumn returns the akas column of a table, with the URN of the
+// resource read from a field of the result of the hydrate function.
+func akasColumn(hydrate plugin.HydrateFunc, field string) *plugin.Column {
+	return &plugin.Column{
+		Name:        "akas",
+		Type:        proto.ColumnType_JSON,
+		Description: "Array of globally unique identifier strings (also known as) for the resource: its URN.",
+		Hydrate:     hydrate,
+		Transform:   transform.FromField(field).Transform(urnAkas),
+	}
+}
+
+// tagsColumn returns the tags column of a table, with the IAM tags read from
+// a field of the result of the hydrate function.
+func tagsColumn(hydrate plugin.HydrateFunc, field string) *plugin.Column {
+	return &plugin.Column{
+		Name:        "tags",
+		Type:        proto.ColumnType_JSON,
+		Description: "IAM tags of the resource. Tags that were internally computed are prefixed with ovh:.",
+		Hydrate:     hydrate,
+		Transform:   transform.FromField(field),
+	}
+}
+
+// urnAkas returns the akas of a URN.
+func urnAkas(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	urn, _ := d.Value.(string)
+	if urn == "" {
+		return nil, nil
+	}
+	return []string{urn}, nil
+}
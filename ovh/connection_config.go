@@ -1,15 +1,31 @@
 package ovh
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/schema"
+	"gopkg.in/ini.v1"
 )
 
 type ovhConfig struct {
-	ApplicationKey    *string `cty:"application_key"`
-	ApplicationSecret *string `cty:"application_secret"`
-	ConsumerKey       *string `cty:"consumer_key"`
-	Endpoint          *string `cty:"endpoint"`
+	ApplicationKey    *string  `cty:"application_key"`
+	ApplicationSecret *string  `cty:"application_secret"`
+	ConsumerKey       *string  `cty:"consumer_key"`
+	ClientID          *string  `cty:"client_id"`
+	ClientSecret      *string  `cty:"client_secret"`
+	Endpoint          *string  `cty:"endpoint"`
+	ProxyURL          *string  `cty:"proxy_url"`
+	CABundle          *string  `cty:"ca_bundle"`
+	Timeout           *int     `cty:"timeout"`
+	MaxRetries        *int     `cty:"max_retries"`
+	DynamicTables     []string `cty:"dynamic_tables"`
+	APISchemaDir      *string  `cty:"api_schema_dir"`
 }
 
 var ConfigSchema = map[string]*schema.Attribute{
@@ -22,9 +38,34 @@ var ConfigSchema = map[string]*schema.Attribute{
 	"consumer_key": {
 		Type: schema.TypeString,
 	},
+	"client_id": {
+		Type: schema.TypeString,
+	},
+	"client_secret": {
+		Type: schema.TypeString,
+	},
 	"endpoint": {
 		Type: schema.TypeString,
 	},
+	"proxy_url": {
+		Type: schema.TypeString,
+	},
+	"ca_bundle": {
+		Type: schema.TypeString,
+	},
+	"timeout": {
+		Type: schema.TypeInt,
+	},
+	"max_retries": {
+		Type: schema.TypeInt,
+	},
+	"dynamic_tables": {
+		Type: schema.TypeList,
+		Elem: &schema.Attribute{Type: schema.TypeString},
+	},
+	"api_schema_dir": {
+		Type: schema.TypeString,
+	},
 }
 
 func ConfigInstance() interface{} {
@@ -39,3 +80,129 @@ func GetConfig(connection *plugin.Connection) ovhConfig {
 	config, _ := connection.GetConfig().(ovhConfig)
 	return config
 }
+
+// connectionConfig returns the config of the connection completed by
+// resolveConfig. It is resolved once per connection config, and then read
+// from the connection cache.
+func connectionConfig(d *plugin.QueryData) (ovhConfig, error) {
+	config := GetConfig(d.Connection)
+	settings, err := json.Marshal(config)
+	if err != nil {
+		return config, err
+	}
+	cacheKey := fmt.Sprintf("ovh-config-%s-%x", d.Connection.Name, sha256.Sum256(settings))
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(ovhConfig), nil
+	}
+
+	config, err = resolveConfig(config)
+	if err != nil {
+		return config, err
+	}
+	d.ConnectionManager.Cache.Set(cacheKey, config)
+	return config, nil
+}
+
+// Configuration files shared with the go-ovh SDK and the OVH CLI, by
+// increasing priority.
+var ovhConfigFiles = []string{
+	"/etc/ovh.conf",
+	"~/.ovh.conf",
+	"./ovh.conf",
+}
+
+// resolveConfig completes the connection config with the OVH_* environment
+// variables and the ovh.conf files. Each setting comes from the first source
+// defining it, in this order:
+//
+//  1. the connection config
+//  2. the OVH_* environment variables
+//  3. the ovh.conf files (the section named after the endpoint)
+//
+// Credentials are never mixed between sources: they all come from the first
+// source defining any of them.
+func resolveConfig(config ovhConfig) (ovhConfig, error) {
+	env := ovhConfig{
+		ApplicationKey:    envValue("OVH_APPLICATION_KEY"),
+		ApplicationSecret: envValue("OVH_APPLICATION_SECRET"),
+		ConsumerKey:       envValue("OVH_CONSUMER_KEY"),
+		ClientID:          envValue("OVH_CLIENT_ID"),
+		ClientSecret:      envValue("OVH_CLIENT_SECRET"),
+		Endpoint:          envValue("OVH_ENDPOINT"),
+	}
+
+	file, err := loadConfigFiles()
+	if err != nil {
+		return config, fmt.Errorf("cannot load ovh.conf: %w", err)
+	}
+
+	if !isSet(config.Endpoint) {
+		config.Endpoint = env.Endpoint
+	}
+	if !isSet(config.Endpoint) {
+		config.Endpoint = fileValue(file, "default", "endpoint")
+	}
+
+	if config.hasCredentials() {
+		return config, nil
+	}
+	if env.hasCredentials() {
+		config.ApplicationKey = env.ApplicationKey
+		config.ApplicationSecret = env.ApplicationSecret
+		config.ConsumerKey = env.ConsumerKey
+		config.ClientID = env.ClientID
+		config.ClientSecret = env.ClientSecret
+		return config, nil
+	}
+	if isSet(config.Endpoint) {
+		section := *config.Endpoint
+		config.ApplicationKey = fileValue(file, section, "application_key")
+		config.ApplicationSecret = fileValue(file, section, "application_secret")
+		config.ConsumerKey = fileValue(file, section, "consumer_key")
+		config.ClientID = fileValue(file, section, "client_id")
+		config.ClientSecret = fileValue(file, section, "client_secret")
+	}
+	return config, nil
+}
+
+func (c ovhConfig) hasCredentials() bool {
+	return isSet(c.ApplicationKey) || isSet(c.ApplicationSecret) || isSet(c.ConsumerKey) || isSet(c.ClientID) || isSet(c.ClientSecret)
+}
+
+func isSet(value *string) bool {
+	return value != nil && *value != ""
+}
+
+func envValue(name string) *string {
+	if value := os.Getenv(name); value != "" {
+		return &value
+	}
+	return nil
+}
+
+func loadConfigFiles() (*ini.File, error) {
+	home, homeErr := os.UserHomeDir()
+	var paths []interface{}
+	for _, path := range ovhConfigFiles {
+		if strings.HasPrefix(path, "~/") {
+			// Ignore the file in the home directory if we cannot find it
+			if homeErr != nil {
+				continue
+			}
+			path = filepath.Join(home, path[2:])
+		}
+		paths = append(paths, path)
+	}
+	return ini.LooseLoad(paths[0], paths[1:]...)
+}
+
+func fileValue(file *ini.File, section, key string) *string {
+	if !file.HasSection(section) || !file.Section(section).HasKey(key) {
+		return nil
+	}
+	value := file.Section(section).Key(key).String()
+	if value == "" {
+		return nil
+	}
+	return &value
+}
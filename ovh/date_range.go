@@ -0,0 +1,71 @@
+package ovh
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/quals"
+)
+
+// dateRangeKeyColumn returns an optional key column of a timestamp column
+// whose range can be filtered by the API.
+func dateRangeKeyColumn(column string) *plugin.KeyColumn {
+	return &plugin.KeyColumn{
+		Name: column,
+		Operators: []string{
+			quals.QualOperatorGreater,
+			quals.QualOperatorGreaterOrEqual,
+			quals.QualOperatorLess,
+			quals.QualOperatorLessOrEqual,
+		},
+		Require: plugin.Optional,
+	}
+}
+
+// dateRangeQuery returns the query string filtering a list on the range of
+// dates of the quals of a column, sent as the param (e.g.
+// ?date.from=2024-01-01T00:00:00Z), or an empty string when there is none.
+// The bounds sent to the API are inclusive, the rows are still checked by
+// Postgres.
+func dateRangeQuery(d *plugin.QueryData, column, param string) string {
+	from, to := dateRange(d, column)
+	values := url.Values{}
+	if !from.IsZero() {
+		values.Set(param+".from", from.Format(time.RFC3339))
+	}
+	if !to.IsZero() {
+		values.Set(param+".to", to.Format(time.RFC3339))
+	}
+	if len(values) == 0 {
+		return ""
+	}
+	return "?" + values.Encode()
+}
+
+// dateRange returns the inclusive range of dates of the quals of a column,
+// the bounds being zero when there is no qual.
+func dateRange(d *plugin.QueryData, column string) (from, to time.Time) {
+	if d.Quals[column] == nil {
+		return
+	}
+
+	for _, q := range d.Quals[column].Quals {
+		timestamp := q.Value.GetTimestampValue()
+		if timestamp == nil {
+			continue
+		}
+		t := timestamp.AsTime()
+		switch q.Operator {
+		case quals.QualOperatorGreater, quals.QualOperatorGreaterOrEqual:
+			if from.IsZero() || t.After(from) {
+				from = t
+			}
+		case quals.QualOperatorLess, quals.QualOperatorLessOrEqual:
+			if to.IsZero() || t.Before(to) {
+				to = t
+			}
+		}
+	}
+	return
+}
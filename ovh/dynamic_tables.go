@@ -0,0 +1,557 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// dynamicTables returns the tables generated from the description of the
+// API paths listed in the dynamic_tables config.
+func dynamicTables(config ovhConfig) (map[string]*plugin.Table, error) {
+	tables := map[string]*plugin.Table{}
+	if len(config.DynamicTables) == 0 {
+		return tables, nil
+	}
+
+	loader, err := newAPISchemaLoader(config)
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range config.DynamicTables {
+		path = strings.TrimSuffix(path, "/")
+		if !strings.HasPrefix(path, "/") {
+			return nil, fmt.Errorf("'dynamic_tables' must contain paths of the API starting with /, got '%s'. Edit your connection configuration file and then restart Steampipe", path)
+		}
+		schema, err := loader.schemaOf(path)
+		if err != nil {
+			return nil, err
+		}
+		table, err := newDynamicTable(schema, path)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := tables[table.name]; ok {
+			return nil, fmt.Errorf("'dynamic_tables' contains several paths named %s. Edit your connection configuration file and then restart Steampipe", table.name)
+		}
+		tables[table.name] = table.table()
+	}
+	return tables, nil
+}
+
+// dynamicRow is a row listed by a dynamic table from a list of ids, with
+// only its key columns. The other columns are hydrated by getItem.
+type dynamicRow map[string]interface{}
+
+// dynamicItem is a row of a dynamic table with all its columns.
+type dynamicItem map[string]interface{}
+
+// dynamicTable is a table generated from the description of a path of the
+// API. The path can return:
+//
+//   - a list of objects, each one being a row
+//   - a list of ids, the details of each id being at the path of the item
+//     (e.g. /vps and /vps/{serviceName})
+//   - an object, being the only row
+//
+// The parameters of the path are the required key columns of the table.
+type dynamicTable struct {
+	name        string
+	description string
+	listPath    string
+	itemPath    string
+	array       bool
+	ids         bool
+	pathParams  []apiSchemaParameter
+	idParam     *apiSchemaParameter
+	columns     []*plugin.Column
+	properties  map[string]string
+}
+
+func newDynamicTable(schema *apiSchema, path string) (*dynamicTable, error) {
+	_, resource := splitAPIPath(path)
+	list := schema.operation(resource, "GET")
+	if list == nil {
+		return nil, fmt.Errorf("the path %s of 'dynamic_tables' has no GET operation in the description of the API", path)
+	}
+
+	t := &dynamicTable{
+		name:        dynamicTableName(path),
+		description: list.Description,
+		listPath:    path,
+		properties:  map[string]string{},
+	}
+	for _, param := range list.Parameters {
+		if param.ParamType == "path" {
+			t.pathParams = append(t.pathParams, param)
+			t.addColumn(snakeCase(param.Name), schema.columnType(param.FullType), param.Description, "", nil)
+		}
+	}
+
+	responseType := list.ResponseType
+	if strings.HasSuffix(responseType, "[]") {
+		t.array = true
+		responseType = strings.TrimSuffix(responseType, "[]")
+		if !schema.isObject(responseType) {
+			t.ids = true
+			responseType = t.addItem(schema, resource)
+		}
+	}
+	if schema.isObject(responseType) {
+		t.addProperties(schema, responseType)
+	} else if !t.ids {
+		t.addColumn("value", schema.columnType(responseType), "Value returned by the API.", "", nil)
+	}
+	t.addStandardColumns()
+	return t, nil
+}
+
+// addStandardColumns adds the title column, and the akas and tags columns of
+// the resources known by the IAM, which have an iam property with their URN
+// and tags.
+func (t *dynamicTable) addStandardColumns() {
+	var hydrate plugin.HydrateFunc
+	if t.itemPath != "" {
+		hydrate = t.getItem
+	}
+
+	// The title is the name of the resource, or its id
+	candidates := []string{"display_name", "name"}
+	if t.idParam != nil {
+		candidates = append(candidates, snakeCase(t.idParam.Name))
+	}
+	for i := len(t.pathParams) - 1; i >= 0; i-- {
+		candidates = append(candidates, snakeCase(t.pathParams[i].Name))
+	}
+	var titleColumns []string
+	for _, name := range candidates {
+		if column := t.column(name); column != nil && column.Type == proto.ColumnType_STRING {
+			titleColumns = append(titleColumns, name)
+		}
+	}
+	if len(titleColumns) > 0 && t.column("title") == nil {
+		t.columns = append(t.columns, &plugin.Column{
+			Name:        "title",
+			Type:        proto.ColumnType_STRING,
+			Description: "Title of the resource.",
+			Hydrate:     hydrate,
+			Transform:   transform.From(dynamicTitle(titleColumns)),
+		})
+	}
+
+	if t.properties["iam"] != "iam" || t.column("akas") != nil || t.column("tags") != nil {
+		return
+	}
+	t.columns = append(t.columns,
+		&plugin.Column{
+			Name:        "akas",
+			Type:        proto.ColumnType_JSON,
+			Description: "Array of globally unique identifier strings (also known as) for the resource: its URN.",
+			Hydrate:     hydrate,
+			Transform:   transform.From(dynamicIamField("urn")).Transform(urnAkas),
+		},
+		&plugin.Column{
+			Name:        "tags",
+			Type:        proto.ColumnType_JSON,
+			Description: "IAM tags of the resource. Tags that were internally computed are prefixed with ovh:.",
+			Hydrate:     hydrate,
+			Transform:   transform.From(dynamicIamField("tags")),
+		},
+	)
+}
+
+// column returns the column with the given name, or nil.
+func (t *dynamicTable) column(name string) *plugin.Column {
+	for _, column := range t.columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+// dynamicTitle returns the transform of the title of the rows of a dynamic
+// table: the value of the first of the columns which is set.
+func dynamicTitle(columns []string) transform.TransformFunc {
+	return func(_ context.Context, d *transform.TransformData) (interface{}, error) {
+		item := rowValues(d.HydrateItem)
+		for _, column := range columns {
+			if value, _ := item[column].(string); value != "" {
+				return value, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// rowValues returns the values of the columns of a listed row or of an item.
+func rowValues(row interface{}) map[string]interface{} {
+	switch row := row.(type) {
+	case dynamicItem:
+		return row
+	case dynamicRow:
+		return row
+	}
+	return nil
+}
+
+// dynamicIamField returns the transform of a field of the iam column of the
+// rows of a dynamic table.
+func dynamicIamField(field string) transform.TransformFunc {
+	return func(_ context.Context, d *transform.TransformData) (interface{}, error) {
+		iam, _ := rowValues(d.HydrateItem)["iam"].(map[string]interface{})
+		return iam[field], nil
+	}
+}
+
+// addItem adds the column of the ids listed by the table, and returns the
+// type of the details of an id if the API has a path for them.
+func (t *dynamicTable) addItem(schema *apiSchema, resource string) string {
+	responseType := ""
+	for _, api := range schema.APIs {
+		param, ok := strings.CutPrefix(api.Path, resource+"/")
+		if !ok || !strings.HasPrefix(param, "{") || !strings.HasSuffix(param, "}") || strings.Contains(param, "/") {
+			continue
+		}
+		get := schema.operation(api.Path, "GET")
+		if get == nil || !schema.isObject(get.ResponseType) {
+			continue
+		}
+		for _, p := range get.Parameters {
+			if p.ParamType == "path" && "{"+p.Name+"}" == param {
+				t.idParam = &p
+				t.itemPath = strings.TrimSuffix(t.listPath, "/") + "/" + param
+				responseType = get.ResponseType
+			}
+		}
+		if t.idParam != nil {
+			break
+		}
+	}
+
+	if t.idParam == nil {
+		t.idParam = &apiSchemaParameter{Name: "id", Description: "ID of the resource."}
+	}
+	t.addColumn(snakeCase(t.idParam.Name), proto.ColumnType_STRING, t.idParam.Description, "", nil)
+	return responseType
+}
+
+// addProperties adds a column for each property of a model, sorted by name.
+func (t *dynamicTable) addProperties(schema *apiSchema, modelType string) {
+	model := schema.Models[modelType]
+	names := make([]string, 0, len(model.Properties))
+	for name := range model.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var hydrate plugin.HydrateFunc
+	if t.ids {
+		hydrate = t.getItem
+	}
+	for _, name := range names {
+		property := model.Properties[name]
+		t.addColumn(snakeCase(name), schema.columnType(property.FullType), property.Description, name, hydrate)
+	}
+}
+
+// addColumn adds a column, unless the table already has a column with the
+// same name (e.g. a parameter of the path also returned in the objects).
+func (t *dynamicTable) addColumn(name string, columnType proto.ColumnType, description, property string, hydrate plugin.HydrateFunc) {
+	if t.column(name) != nil {
+		if property != "" && t.properties[name] == "" {
+			t.properties[name] = property
+		}
+		return
+	}
+	t.columns = append(t.columns, &plugin.Column{
+		Name:        name,
+		Type:        columnType,
+		Description: description,
+		Hydrate:     hydrate,
+		Transform:   transform.FromField(name),
+	})
+	if property != "" {
+		t.properties[name] = property
+	}
+}
+
+func (t *dynamicTable) table() *plugin.Table {
+	var keyColumns []string
+	for _, param := range t.pathParams {
+		keyColumns = append(keyColumns, snakeCase(param.Name))
+	}
+
+	table := &plugin.Table{
+		Name:        t.name,
+		Description: t.description,
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.AllColumns(keyColumns),
+			Hydrate:    t.list,
+		},
+		Columns: t.columns,
+	}
+	if t.itemPath != "" {
+		table.Get = &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns(append(keyColumns, snakeCase(t.idParam.Name))),
+			Hydrate:      t.get,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		}
+		table.HydrateConfig = []plugin.HydrateConfig{
+			{
+				Func:           t.getItem,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		}
+	}
+	return table
+}
+
+func (t *dynamicTable) list(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(t.name+".list", "connection_error", err)
+		return nil, err
+	}
+
+	keys := t.keys(d)
+	path := expandPath(t.listPath, t.pathParams, keys)
+	if t.array && strings.HasPrefix(path, "/v2/") {
+		err = listPages(ctx, d, client, path, func(element interface{}) {
+			t.stream(ctx, d, keys, element)
+		})
+		if err != nil {
+			plugin.Logger(ctx).Error(t.name+".list", err)
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	var response interface{}
+	if err := client.GetWithContext(ctx, path, &response); err != nil {
+		plugin.Logger(ctx).Error(t.name+".list", err)
+		return nil, err
+	}
+
+	elements, ok := response.([]interface{})
+	if !ok {
+		d.StreamListItem(ctx, t.item(keys, response))
+		return nil, nil
+	}
+	for _, element := range elements {
+		t.stream(ctx, d, keys, element)
+		if rowsRemaining(ctx, d) == 0 {
+			break
+		}
+	}
+	return nil, nil
+}
+
+// stream streams the row of an element of the list returned by the API.
+func (t *dynamicTable) stream(ctx context.Context, d *plugin.QueryData, keys map[string]interface{}, element interface{}) {
+	if !t.ids {
+		d.StreamListItem(ctx, t.item(keys, element))
+		return
+	}
+	row := dynamicRow{}
+	for column, value := range keys {
+		row[column] = value
+	}
+	row[snakeCase(t.idParam.Name)] = idString(element)
+	d.StreamListItem(ctx, row)
+}
+
+func (t *dynamicTable) get(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	row := dynamicRow(t.keys(d))
+	id := snakeCase(t.idParam.Name)
+	row[id] = d.EqualsQuals[id].GetStringValue()
+	item, err := t.getItem(ctx, d, &plugin.HydrateData{Item: row})
+	if err != nil || item == nil {
+		return nil, err
+	}
+	return item, nil
+}
+
+// getItem returns the details of a row listed from a list of ids.
+func (t *dynamicTable) getItem(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	if item, ok := h.Item.(dynamicItem); ok {
+		return item, nil
+	}
+	row := h.Item.(dynamicRow)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(t.name+".getItem", "connection_error", err)
+		return nil, err
+	}
+
+	params := append([]apiSchemaParameter{*t.idParam}, t.pathParams...)
+	var response interface{}
+	if err := client.GetWithContext(ctx, expandPath(t.itemPath, params, row), &response); err != nil {
+		plugin.Logger(ctx).Error(t.name+".getItem", err)
+		return nil, err
+	}
+	return t.item(row, response), nil
+}
+
+// keys returns the values of the key columns of the path parameters.
+func (t *dynamicTable) keys(d *plugin.QueryData) map[string]interface{} {
+	keys := map[string]interface{}{}
+	for _, param := range t.pathParams {
+		column := snakeCase(param.Name)
+		keys[column] = grpc.GetQualValue(d.EqualsQuals[column])
+	}
+	return keys
+}
+
+// item returns the row of an object returned by the API, with the values
+// converted to the types of the columns.
+func (t *dynamicTable) item(keys map[string]interface{}, response interface{}) dynamicItem {
+	item := dynamicItem{}
+	for column, value := range keys {
+		item[column] = value
+	}
+
+	object, ok := response.(map[string]interface{})
+	if !ok {
+		item["value"] = response
+		object = map[string]interface{}{}
+	}
+	for _, column := range t.columns {
+		property, ok := t.properties[column.Name]
+		if !ok {
+			if value, ok := item[column.Name]; ok {
+				item[column.Name] = columnValue(column.Type, value)
+			}
+			continue
+		}
+		if _, ok := keys[column.Name]; ok && object[property] == nil {
+			continue
+		}
+		item[column.Name] = columnValue(column.Type, object[property])
+	}
+	return item
+}
+
+// columnValue converts a value decoded from the API, with its numbers as
+// json.Number, to the type of its column.
+func columnValue(columnType proto.ColumnType, value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	switch columnType {
+	case proto.ColumnType_INT:
+		if n, ok := value.(json.Number); ok {
+			if i, err := n.Int64(); err == nil {
+				return i
+			}
+			f, _ := n.Float64()
+			return int64(f)
+		}
+	case proto.ColumnType_DOUBLE:
+		if n, ok := value.(json.Number); ok {
+			f, _ := n.Float64()
+			return f
+		}
+	case proto.ColumnType_STRING:
+		switch v := value.(type) {
+		case string:
+			return v
+		case json.Number:
+			return v.String()
+		}
+		b, _ := json.Marshal(value)
+		return string(b)
+	case proto.ColumnType_JSON:
+		// The SDK takes the strings of JSON columns as raw JSON
+		if s, ok := value.(string); ok {
+			b, _ := json.Marshal(s)
+			return string(b)
+		}
+	}
+	return value
+}
+
+// columnType returns the type of the column of a value of the API.
+func (s *apiSchema) columnType(fullType string) proto.ColumnType {
+	if strings.HasSuffix(fullType, "[]") || strings.HasPrefix(fullType, "map[") {
+		return proto.ColumnType_JSON
+	}
+	switch fullType {
+	case "boolean":
+		return proto.ColumnType_BOOL
+	case "long", "int", "integer":
+		return proto.ColumnType_INT
+	case "double", "float", "number":
+		return proto.ColumnType_DOUBLE
+	case "datetime", "date", "time":
+		return proto.ColumnType_TIMESTAMP
+	case "ip", "ipBlock", "ipv4", "ipv4Block", "ipv6", "ipv6Block":
+		return proto.ColumnType_STRING
+	}
+	if s.isObject(fullType) {
+		return proto.ColumnType_JSON
+	}
+	return proto.ColumnType_STRING
+}
+
+// isObject returns true if the type is a model with properties, false for
+// the enums and the primitive types.
+func (s *apiSchema) isObject(modelType string) bool {
+	model, ok := s.Models[modelType]
+	return ok && len(model.Enum) == 0
+}
+
+// expandPath replaces the parameters of a path by their value in row.
+func expandPath(path string, params []apiSchemaParameter, row map[string]interface{}) string {
+	for _, param := range params {
+		value := url.PathEscape(idString(row[snakeCase(param.Name)]))
+		path = strings.ReplaceAll(path, "{"+param.Name+"}", value)
+	}
+	return path
+}
+
+func idString(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	return fmt.Sprint(value)
+}
+
+// dynamicTableName returns the name of the table of a path, made of its
+// segments without the parameters: /cloud/project/{serviceName}/kube is
+// ovh_cloud_project_kube.
+func dynamicTableName(path string) string {
+	_, resource := splitAPIPath(path)
+	var segments []string
+	for _, segment := range strings.Split(strings.Trim(resource, "/"), "/") {
+		if !strings.HasPrefix(segment, "{") {
+			segments = append(segments, snakeCase(segment))
+		}
+	}
+	return "ovh_" + strings.Join(segments, "_")
+}
+
+var (
+	snakeCaseWords    = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+	snakeCaseAcronyms = regexp.MustCompile(`([A-Z]+)([A-Z][a-z])`)
+)
+
+// snakeCase converts the name of a property (e.g. serviceName or
+// IAMActions) to the name of a column (service_name or iam_actions).
+func snakeCase(name string) string {
+	name = snakeCaseAcronyms.ReplaceAllString(name, "${1}_${2}")
+	name = snakeCaseWords.ReplaceAllString(name, "${1}_${2}")
+	name = strings.NewReplacer("-", "_", ".", "_").Replace(name)
+	return strings.ToLower(name)
+}
@@ -0,0 +1,237 @@
+package ovh
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+)
+
+// dynamicTableMap returns the tables of a connection with the given config.
+func dynamicTableMap(t *testing.T, config ovhConfig) (map[string]*plugin.Table, error) {
+	t.Helper()
+	ctx := context.Background()
+	conn := &plugin.Connection{Name: t.Name()}
+	conn.SetConfig(config)
+	return Plugin(ctx).TableMapFunc(ctx, &plugin.TableMapData{Connection: conn})
+}
+
+func TestDynamicTables(t *testing.T) {
+	api := newFakeAPI(t)
+	endpoint := api.Endpoint()
+	tables, err := dynamicTableMap(t, ovhConfig{
+		Endpoint:      &endpoint,
+		DynamicTables: []string{"/vps", "/vps/{serviceName}/ips", "/vps/{serviceName}/datacenter", "/vps/{serviceName}/option", "/v2/iam/policy"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := tables["ovh_cloud_project"]; !ok {
+		t.Error("the tables of the plugin are missing")
+	}
+
+	columnTypes := map[string]map[string]proto.ColumnType{
+		"ovh_vps": {
+			"service_name":         proto.ColumnType_STRING,
+			"display_name":         proto.ColumnType_STRING,
+			"memory_limit":         proto.ColumnType_INT,
+			"monitoring_ip_blocks": proto.ColumnType_JSON,
+			"sla_monitoring":       proto.ColumnType_BOOL,
+			"state":                proto.ColumnType_STRING,
+			"iam":                  proto.ColumnType_JSON,
+		},
+		"ovh_vps_ips": {
+			"service_name": proto.ColumnType_STRING,
+			"ip_address":   proto.ColumnType_STRING,
+			"version":      proto.ColumnType_STRING,
+		},
+		"ovh_vps_datacenter": {
+			"service_name": proto.ColumnType_STRING,
+			"long_name":    proto.ColumnType_STRING,
+		},
+		"ovh_vps_option": {
+			"service_name": proto.ColumnType_STRING,
+			"id":           proto.ColumnType_STRING,
+		},
+		"ovh_iam_policy": {
+			"id":         proto.ColumnType_STRING,
+			"created_at": proto.ColumnType_TIMESTAMP,
+			"identities": proto.ColumnType_JSON,
+			"read_only":  proto.ColumnType_BOOL,
+		},
+	}
+	for name, expected := range columnTypes {
+		table, ok := tables[name]
+		if !ok {
+			t.Errorf("table %s does not exist", name)
+			continue
+		}
+		for column, columnType := range expected {
+			c := columnOf(table, column)
+			if c == nil {
+				t.Errorf("column %s.%s does not exist", name, column)
+			} else if c.Type != columnType {
+				t.Errorf("column %s.%s has the type %s, expected %s", name, column, c.Type, columnType)
+			}
+		}
+	}
+
+	t.Run("list ids", func(t *testing.T) {
+		rows, err := newTableQuery(t, api, tables["ovh_vps"], nil).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRows(t, rows, "service_name", []row{
+			{"service_name": "vps-0a1b2c3d.vps.ovh.net", "display_name": "web", "memory_limit": 4096, "vcore": 2, "state": "running", "sla_monitoring": false, "monitoring_ip_blocks": []string{"192.0.2.0/24"}, "iam": row{"id": "6a2f1a4e-2c5e-4f55-9a61-3f4b1e6c7d80", "urn": "urn:v1:eu:resource:vps:vps-0a1b2c3d.vps.ovh.net", "displayName": "web", "tags": row{"env": "production"}}, "title": "web", "akas": []string{"urn:v1:eu:resource:vps:vps-0a1b2c3d.vps.ovh.net"}, "tags": row{"env": "production"}},
+			// The details of the expired services are ignored
+			{"service_name": "vps-4e5f6a7b.vps.ovh.net", "display_name": nil, "state": nil, "title": nil},
+		})
+	})
+
+	t.Run("get", func(t *testing.T) {
+		row, err := newTableQuery(t, api, tables["ovh_vps"], testQuals{"service_name": "vps-0a1b2c3d.vps.ovh.net"}).Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRow(t, row, testQuals{"service_name": "vps-0a1b2c3d.vps.ovh.net", "display_name": "web", "zone": "Region OpenStack: os-gra1"})
+
+		row, err = newTableQuery(t, api, tables["ovh_vps"], testQuals{"service_name": "vps-ffffffff.vps.ovh.net"}).Get()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if row != nil {
+			t.Errorf("got %v for a VPS which does not exist", row)
+		}
+	})
+
+	t.Run("list ids with path params", func(t *testing.T) {
+		rows, err := newTableQuery(t, api, tables["ovh_vps_ips"], testQuals{"service_name": "vps-0a1b2c3d.vps.ovh.net"}).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRows(t, rows, "ip_address", []row{
+			{"service_name": "vps-0a1b2c3d.vps.ovh.net", "ip_address": "198.51.100.10", "version": "v4", "reverse": "web.example.com.", "title": "198.51.100.10"},
+			{"service_name": "vps-0a1b2c3d.vps.ovh.net", "ip_address": "198.51.100.11", "version": "v4", "reverse": nil},
+		})
+	})
+
+	t.Run("object", func(t *testing.T) {
+		rows, err := newTableQuery(t, api, tables["ovh_vps_datacenter"], testQuals{"service_name": "vps-0a1b2c3d.vps.ovh.net"}).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRows(t, rows, "name", []row{
+			{"service_name": "vps-0a1b2c3d.vps.ovh.net", "name": "gra", "long_name": "Gravelines", "country": "fr", "title": "gra"},
+		})
+	})
+
+	t.Run("list objects", func(t *testing.T) {
+		rows, err := newTableQuery(t, api, tables["ovh_iam_policy"], nil).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRows(t, rows, "name", []row{
+			{"id": "1c8e3f4a-5b6d-4e7f-8a9b-0c1d2e3f4a5b", "name": "admins", "identities": []string{"urn:v1:eu:identity:group:xx1111-ovh/admins"}, "read_only": false, "created_at": "2024-02-01T09:00:00Z", "title": "admins"},
+			{"id": "7d6c5b4a-3e2f-4a1b-9c8d-7e6f5a4b3c2d", "name": "ovh-default", "description": nil, "identities": []string{}, "read_only": true},
+		})
+	})
+
+	t.Run("pages", func(t *testing.T) {
+		api.pageSize = 1
+		defer func() { api.pageSize = 0 }()
+
+		rows, err := newTableQuery(t, api, tables["ovh_iam_policy"], nil).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 2 {
+			t.Errorf("got %d rows, expected 2", len(rows))
+		}
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		rows, err := newTableQuery(t, api, tables["ovh_iam_policy"], nil).Limit(1).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 1 {
+			t.Errorf("got %d rows, expected 1", len(rows))
+		}
+	})
+}
+
+func columnOf(table *plugin.Table, name string) *plugin.Column {
+	for _, column := range table.Columns {
+		if column.Name == name {
+			return column
+		}
+	}
+	return nil
+}
+
+func TestDynamicTablesFromDir(t *testing.T) {
+	dir := "testdata/schema"
+	tables, err := dynamicTableMap(t, ovhConfig{
+		APISchemaDir:  &dir,
+		DynamicTables: []string{"/vps/{serviceName}/ips/"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	table, ok := tables["ovh_vps_ips"]
+	if !ok {
+		t.Fatal("table ovh_vps_ips does not exist")
+	}
+	if table.Get == nil || len(table.Get.KeyColumns) != 2 {
+		t.Errorf("table ovh_vps_ips must be get by service_name and ip_address")
+	}
+}
+
+func TestDynamicTablesErrors(t *testing.T) {
+	dir := "testdata/schema"
+	tests := map[string]struct {
+		paths []string
+		err   string
+	}{
+		"relative path": {
+			paths: []string{"vps"},
+			err:   "must contain paths of the API starting with /",
+		},
+		"unknown API": {
+			paths: []string{"/unknown/path"},
+			err:   "no description of the API found for /unknown/path",
+		},
+		"no GET operation": {
+			paths: []string{"/vps/{serviceName}/unknown"},
+			err:   "has no GET operation",
+		},
+		"same name": {
+			paths: []string{"/vps/{serviceName}/ips", "/vps/{serviceName}/ips/"},
+			err:   "contains several paths named ovh_vps_ips",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := dynamicTableMap(t, ovhConfig{APISchemaDir: &dir, DynamicTables: test.paths})
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("got error %v, expected %q", err, test.err)
+			}
+		})
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	for name, expected := range map[string]string{
+		"serviceName":         "service_name",
+		"IAMActions":          "iam_actions",
+		"ipv6":                "ipv6",
+		"dataProcessing":      "data_processing",
+		"X-Pagination-Cursor": "x_pagination_cursor",
+	} {
+		if got := snakeCase(name); got != expected {
+			t.Errorf("snakeCase(%q) = %q, expected %q", name, got, expected)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package ovh
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/quals"
+)
+
+// iamTagsKeyColumns returns the key columns of the tables listing resources
+// filtered by the API on their IAM tags: the quals on the tags column, like
+// tags @> '{"env": "prod"}' or tags ? 'env', are sent to the API.
+func iamTagsKeyColumns() plugin.KeyColumnSlice {
+	return plugin.KeyColumnSlice{
+		{
+			Name: "tags",
+			Operators: []string{
+				quals.QualOperatorEqual,
+				quals.QualOperatorJsonbContainsLeftRight,
+				quals.QualOperatorJsonbExistsOne,
+				quals.QualOperatorJsonbExistsAll,
+			},
+			Require: plugin.Optional,
+		},
+	}
+}
+
+// iamTagFilter is a filter of the resources on the value of one of their
+// IAM tags.
+type iamTagFilter struct {
+	Operator string `json:"operator"`
+	Value    string `json:"value,omitempty"`
+}
+
+// iamTagsQuery returns the query string filtering a list of resources on the
+// quals of the tags column (e.g. ?iamTags={"env":[{"operator":"EQ","value":"prod"}]}),
+// or an empty string when there is none. The filters of all the quals must
+// match, the rows are still checked by Postgres.
+func iamTagsQuery(d *plugin.QueryData, param string) string {
+	if d.Quals["tags"] == nil {
+		return ""
+	}
+
+	filters := map[string][]iamTagFilter{}
+	for _, q := range d.Quals["tags"].Quals {
+		switch q.Operator {
+		case quals.QualOperatorEqual, quals.QualOperatorJsonbContainsLeftRight:
+			value := q.Value.GetJsonbValue()
+			if value == "" {
+				value = q.Value.GetStringValue()
+			}
+			var tags map[string]interface{}
+			if err := json.Unmarshal([]byte(value), &tags); err != nil {
+				continue
+			}
+			for key, value := range tags {
+				// The values of the tags are always strings, no resource
+				// matches another value
+				if s, ok := value.(string); ok {
+					filters[key] = append(filters[key], iamTagFilter{Operator: "EQ", Value: s})
+				}
+			}
+		case quals.QualOperatorJsonbExistsOne:
+			key := q.Value.GetStringValue()
+			filters[key] = append(filters[key], iamTagFilter{Operator: "EXISTS"})
+		case quals.QualOperatorJsonbExistsAll:
+			for _, v := range q.Value.GetListValue().GetValues() {
+				key := v.GetStringValue()
+				filters[key] = append(filters[key], iamTagFilter{Operator: "EXISTS"})
+			}
+		}
+	}
+	if len(filters) == 0 {
+		return ""
+	}
+
+	value, _ := json.Marshal(filters)
+	return "?" + url.Values{param: {string(value)}}.Encode()
+}
@@ -0,0 +1,57 @@
+package ovh
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+)
+
+// Maximum number of elements requested per page to the v2 API.
+const maxPageSize = 100
+
+// listPages calls streamFunc for each element of a list of the v2 API. The
+// v2 API returns the lists by pages: the cursor of the next page is given in
+// the X-Pagination-Cursor-Next header, and is sent back in the
+// X-Pagination-Cursor header. Pages are requested until the last one, or
+// until the limit of the query is reached.
+func listPages[T any](ctx context.Context, d *plugin.QueryData, client *ovh.Client, path string, streamFunc func(T)) error {
+	cursor := ""
+	for {
+		remaining := rowsRemaining(ctx, d)
+		if remaining == 0 {
+			return nil
+		}
+		pageSize := min(remaining, maxPageSize)
+
+		req, err := client.NewRequest(http.MethodGet, path, nil, true)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("X-Pagination-Size", strconv.FormatInt(pageSize, 10))
+		if cursor != "" {
+			req.Header.Set("X-Pagination-Cursor", cursor)
+		}
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+		cursor = resp.Header.Get("X-Pagination-Cursor-Next")
+
+		var page []T
+		if err := client.UnmarshalResponse(resp, &page); err != nil {
+			return err
+		}
+		for _, element := range page {
+			streamFunc(element)
+			if rowsRemaining(ctx, d) == 0 {
+				return nil
+			}
+		}
+		if cursor == "" || len(page) == 0 {
+			return nil
+		}
+	}
+}
@@ -2,11 +2,17 @@ package ovh
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+	"github.com/turbot/steampipe-plugin-sdk/v6/rate_limiter"
 )
 
+// Maximum number of concurrent calls of the hydrate functions fetching the
+// details of each row.
+const hydrateConcurrency = 10
+
 func Plugin(ctx context.Context) *plugin.Plugin {
 	p := &plugin.Plugin{
 		Name:             "steampipe-plugin-ovh",
@@ -15,32 +21,100 @@ func Plugin(ctx context.Context) *plugin.Plugin {
 			NewInstance: ConfigInstance,
 			Schema:      ConfigSchema,
 		},
-		TableMap: map[string]*plugin.Table{
-			"ovh_bill":                  tableOvhBill(),
-			"ovh_bill_detail":           tableOvhBillDetails(),
-			"ovh_ceph":                  tableOvhCeph(),
-			"ovh_cloud_ai_app":          tableOvhCloudAIApp(),
-			"ovh_cloud_ai_job":          tableOvhCloudAIJob(),
-			"ovh_cloud_ai_notebook":     tableOvhCloudAINotebook(),
-			"ovh_cloud_data_job":        tableOvhCloudDataJob(),
-			"ovh_cloud_database":        tableOvhCloudDatabase(),
-			"ovh_cloud_flavor":          tableOvhCloudFlavor(),
-			"ovh_cloud_image":           tableOvhCloudImage(),
-			"ovh_cloud_instance":        tableOvhCloudInstance(),
-			"ovh_cloud_postgres":        tableOvhCloudPostgres(),
-			"ovh_cloud_project":         tableOvhCloudProject(),
-			"ovh_cloud_region":          tableOvhCloudRegion(),
-			"ovh_cloud_ssh_key":         tableOvhCloudSshKey(),
-			"ovh_cloud_storage_s3":      tableOvhCloudStorageS3(),
-			"ovh_cloud_storage_swift":   tableOvhCloudStorageSwift(),
-			"ovh_cloud_volume":          tableOvhCloudVolume(),
-			"ovh_cloud_volume_snapshot": tableOvhCloudVolumeSnapshot(),
-			"ovh_dedicated_server":      tableOvhDedicatedServer(ctx),
-			"ovh_iam_resource":          tableOvhIamResource(),
-			"ovh_log_self":              tableOvhLog(),
-			"ovh_refund":                tableOvhRefund(),
-			"ovh_refund_detail":         tableOvhRefundDetails(),
+		RateLimiters: []*rate_limiter.Definition{
+			{
+				// Requests per second sent to the OVH API by each connection
+				Name:       "ovh_api",
+				FillRate:   20,
+				BucketSize: 50,
+				Scope:      []string{"connection"},
+			},
 		},
+		SchemaMode:   plugin.SchemaModeDynamic,
+		TableMapFunc: tableMap,
 	}
 	return p
 }
+
+// tableMap returns the tables of a connection: the tables of the plugin and
+// the ones generated from the API paths of the dynamic_tables config.
+func tableMap(ctx context.Context, d *plugin.TableMapData) (map[string]*plugin.Table, error) {
+	tables := staticTables(ctx)
+	dynamic, err := dynamicTables(GetConfig(d.Connection))
+	if err != nil {
+		return nil, err
+	}
+	for name, table := range dynamic {
+		if _, ok := tables[name]; ok {
+			return nil, fmt.Errorf("the table %s of 'dynamic_tables' already exists, remove its path from the connection configuration file and then restart Steampipe", name)
+		}
+		tables[name] = table
+	}
+	return tables, nil
+}
+
+func staticTables(ctx context.Context) map[string]*plugin.Table {
+	return map[string]*plugin.Table{
+		"ovh_api_request":                   tableOvhApiRequest(),
+		"ovh_api_schema":                    tableOvhApiSchema(),
+		"ovh_bill":                          tableOvhBill(),
+		"ovh_bill_detail":                   tableOvhBillDetails(),
+		"ovh_ceph":                          tableOvhCeph(),
+		"ovh_cloud_ai_app":                  tableOvhCloudAIApp(),
+		"ovh_cloud_ai_job":                  tableOvhCloudAIJob(),
+		"ovh_cloud_ai_notebook":             tableOvhCloudAINotebook(),
+		"ovh_cloud_data_job":                tableOvhCloudDataJob(),
+		"ovh_cloud_database":                tableOvhCloudDatabase(),
+		"ovh_cloud_flavor":                  tableOvhCloudFlavor(),
+		"ovh_cloud_floating_ip":             tableOvhCloudFloatingIP(),
+		"ovh_cloud_gateway":                 tableOvhCloudGateway(),
+		"ovh_cloud_image":                   tableOvhCloudImage(),
+		"ovh_cloud_instance":                tableOvhCloudInstance(),
+		"ovh_cloud_instance_interface":      tableOvhCloudInstanceInterface(),
+		"ovh_cloud_kube_cluster":            tableOvhCloudKubeCluster(),
+		"ovh_cloud_kube_node":               tableOvhCloudKubeNode(),
+		"ovh_cloud_kube_node_pool":          tableOvhCloudKubeNodePool(),
+		"ovh_cloud_loadbalancer":            tableOvhCloudLoadBalancer(),
+		"ovh_cloud_loadbalancer_listener":   tableOvhCloudLoadBalancerListener(),
+		"ovh_cloud_loadbalancer_member":     tableOvhCloudLoadBalancerMember(),
+		"ovh_cloud_loadbalancer_pool":       tableOvhCloudLoadBalancerPool(),
+		"ovh_cloud_network_private":         tableOvhCloudNetworkPrivate(),
+		"ovh_cloud_network_public":          tableOvhCloudNetworkPublic(),
+		"ovh_cloud_network_subnet":          tableOvhCloudNetworkSubnet(),
+		"ovh_cloud_postgres":                tableOvhCloudPostgres(),
+		"ovh_cloud_project":                 tableOvhCloudProject(),
+		"ovh_cloud_region":                  tableOvhCloudRegion(),
+		"ovh_cloud_registry":                tableOvhCloudRegistry(),
+		"ovh_cloud_registry_ip_restriction": tableOvhCloudRegistryIPRestriction(),
+		"ovh_cloud_registry_user":           tableOvhCloudRegistryUser(),
+		"ovh_cloud_ssh_key":                 tableOvhCloudSshKey(),
+		"ovh_cloud_storage_s3":              tableOvhCloudStorageS3(),
+		"ovh_cloud_storage_swift":           tableOvhCloudStorageSwift(),
+		"ovh_cloud_volume":                  tableOvhCloudVolume(),
+		"ovh_cloud_volume_snapshot":         tableOvhCloudVolumeSnapshot(),
+		"ovh_dedicated_server":              tableOvhDedicatedServer(ctx),
+		"ovh_dns_record":                    tableOvhDNSRecord(),
+		"ovh_dns_zone":                      tableOvhDNSZone(),
+		"ovh_dns_zone_history":              tableOvhDNSZoneHistory(),
+		"ovh_domain":                        tableOvhDomain(),
+		"ovh_iam_resource":                  tableOvhIamResource(),
+		"ovh_ip":                            tableOvhIP(),
+		"ovh_ip_firewall_rule":              tableOvhIPFirewallRule(),
+		"ovh_ip_mitigation":                 tableOvhIPMitigation(),
+		"ovh_ip_move":                       tableOvhIPMove(),
+		"ovh_ip_reverse":                    tableOvhIPReverse(),
+		"ovh_iplb":                          tableOvhIPLB(),
+		"ovh_iplb_farm":                     tableOvhIPLBFarm(),
+		"ovh_iplb_frontend":                 tableOvhIPLBFrontend(),
+		"ovh_iplb_pending_change":           tableOvhIPLBPendingChange(),
+		"ovh_iplb_route":                    tableOvhIPLBRoute(),
+		"ovh_iplb_server":                   tableOvhIPLBServer(),
+		"ovh_iplb_ssl":                      tableOvhIPLBSSL(),
+		"ovh_log_self":                      tableOvhLog(),
+		"ovh_log_service":                   tableOvhLogService(),
+		"ovh_refund":                        tableOvhRefund(),
+		"ovh_refund_detail":                 tableOvhRefundDetails(),
+		"ovh_vrack":                         tableOvhVrack(),
+		"ovh_vrack_member":                  tableOvhVrackMember(),
+	}
+}
@@ -0,0 +1,74 @@
+package ovh
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+)
+
+// queryLimits are the limits of a query: the rows it still requires and the
+// rate limiters of its list call. *plugin.QueryData implements it.
+type queryLimits interface {
+	RowsRemaining(ctx context.Context) int64
+	WaitForListRateLimit(ctx context.Context)
+}
+
+type queryLimitsKey struct{}
+
+// withQueryLimits returns a context giving other limits than the ones of the
+// QueryData, as the tests do: their QueryData is not built by the SDK.
+func withQueryLimits(ctx context.Context, limits queryLimits) context.Context {
+	return context.WithValue(ctx, queryLimitsKey{}, limits)
+}
+
+// limitsOf returns the limits of the query, or the ones given by ctx.
+func limitsOf(ctx context.Context, d *plugin.QueryData) queryLimits {
+	if limits, ok := ctx.Value(queryLimitsKey{}).(queryLimits); ok {
+		return limits
+	}
+	return d
+}
+
+// rateLimitTransport waits for the rate limiters of a list call before each
+// request but the first one: the SDK already waits for them before calling
+// the list function, which sends at least one request. Listing the resources
+// of every project, or every page of a list, then takes a token per request.
+type rateLimitTransport struct {
+	next     http.RoundTripper
+	wait     func()
+	requests atomic.Int64
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.requests.Add(1) > 1 {
+		t.wait()
+	}
+	return t.next.RoundTrip(req)
+}
+
+// connectList returns the client of a list call, whose requests wait for the
+// rate limiters of the list, retries included. The hydrate calls use connect:
+// the SDK waits for their own rate limiters, declared by the scopes and tags
+// of their HydrateConfig, before calling them.
+func connectList(ctx context.Context, d *plugin.QueryData) (*ovh.Client, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+	limiter := &rateLimitTransport{
+		next: client.Client.Transport,
+		wait: func() { limitsOf(ctx, d).WaitForListRateLimit(ctx) },
+	}
+	client.Client.Transport = limiter
+	// The limiter goes under the retries so that each attempt waits
+	if retry, ok := limiter.next.(*retryTransport); ok {
+		retryCopy := *retry
+		limiter.next = retry.next
+		retryCopy.next = limiter
+		client.Client.Transport = &retryCopy
+	}
+	return client, nil
+}
@@ -0,0 +1,56 @@
+package ovh
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestRateLimitEveryRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		table      string
+		quals      testQuals
+		maxRetries int
+		setup      func(api *fakeAPI)
+	}{
+		{name: "projects", table: "ovh_cloud_ssh_key"},
+		{name: "pages", table: "ovh_iam_resource", setup: func(api *fakeAPI) { api.pageSize = 1 }},
+		{name: "retries", table: "ovh_cloud_ssh_key", maxRetries: 1, setup: func(api *fakeAPI) {
+			api.Fail("/1.0/cloud/project/"+testProject1+"/sshkey", fakeFailure{code: http.StatusServiceUnavailable})
+		}},
+		{name: "details of the filtered logs", table: "ovh_log_self", quals: testQuals{"method": "GET"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			if test.setup != nil {
+				test.setup(api)
+			}
+			query := newTestQuery(t, api, test.table, test.quals).MaxRetries(test.maxRetries)
+			if _, err := query.List(); err != nil {
+				t.Fatal(err)
+			}
+			// The SDK waits for the rate limiters before the first request
+			requests := api.Requests()
+			if len(requests) < 2 {
+				t.Fatalf("got the requests %v, expected several", requests)
+			}
+			if waits := query.Waits(); waits != int64(len(requests)-1) {
+				t.Errorf("got %d waits for the requests %v, expected %d", waits, requests, len(requests)-1)
+			}
+		})
+	}
+}
+
+func TestRateLimitHydrateCalls(t *testing.T) {
+	// The SDK waits for the rate limiters of the get and hydrate calls
+	api := newFakeAPI(t)
+	query := newTestQuery(t, api, "ovh_cloud_ssh_key", testQuals{"project_id": testProject1, "id": "5a6b7c"})
+	if _, err := query.Get(); err != nil {
+		t.Fatal(err)
+	}
+	if waits := query.Waits(); waits != 0 {
+		t.Errorf("got %d waits, expected none", waits)
+	}
+}
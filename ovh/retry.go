@@ -0,0 +1,101 @@
+package ovh
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default number of retries of a request throttled or failed on the OVH side.
+const defaultMaxRetries = 5
+
+// Bounds of the delay between two attempts of a request.
+const (
+	minRetryDelay = 500 * time.Millisecond
+	maxRetryDelay = 60 * time.Second
+)
+
+// retryTransport retries the requests answered with a 429 or 5xx status
+// code, which the go-ovh client turns into an ovh.APIError. It waits for the
+// delay given by the Retry-After header, or an exponential backoff without it.
+//
+// Signed requests are signed again before being retried, as the OVH API
+// rejects signatures with an outdated timestamp.
+type retryTransport struct {
+	next        http.RoundTripper
+	maxRetries  int
+	appSecret   string
+	consumerKey string
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	for attempt := 0; ; attempt++ {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil || attempt >= t.maxRetries || !isRetryableStatus(resp.StatusCode) || !isIdempotent(req.Method) {
+			return resp, err
+		}
+
+		delay := retryDelay(resp, attempt)
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		req = t.resign(req, time.Since(start))
+	}
+}
+
+func isRetryableStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+func isIdempotent(method string) bool {
+	return method == http.MethodGet || method == http.MethodHead
+}
+
+// retryDelay returns how long to wait before the next attempt.
+func retryDelay(resp *http.Response, attempt int) time.Duration {
+	delay := minRetryDelay << attempt
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			delay = time.Duration(seconds) * time.Second
+		} else if date, err := http.ParseTime(retryAfter); err == nil {
+			delay = time.Until(date)
+		}
+	}
+	return min(max(delay, minRetryDelay), maxRetryDelay)
+}
+
+// resign returns a copy of req with its timestamp moved forward by elapsed
+// and the matching signature. Requests which are not signed with an
+// application key are returned as is.
+func (t *retryTransport) resign(req *http.Request, elapsed time.Duration) *http.Request {
+	timestamp, err := strconv.ParseInt(req.Header.Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil || req.Header.Get("X-Ovh-Signature") == "" {
+		return req
+	}
+	timestamp += int64(elapsed.Seconds())
+
+	req = req.Clone(req.Context())
+	req.Header.Set("X-Ovh-Timestamp", strconv.FormatInt(timestamp, 10))
+	req.Header.Set("X-Ovh-Signature", signature(t.appSecret, t.consumerKey, req.Method, req.URL.String(), "", timestamp))
+	return req
+}
+
+// signature computes the X-Ovh-Signature header of a request, the same way
+// as the go-ovh client.
+func signature(appSecret, consumerKey, method, target, body string, timestamp int64) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "%s+%s+%s+%s+%s+%d", appSecret, consumerKey, method, target, body, timestamp)
+	return fmt.Sprintf("$1$%x", h.Sum(nil))
+}
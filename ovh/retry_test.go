@@ -0,0 +1,154 @@
+package ovh
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func TestRetryThrottledRequest(t *testing.T) {
+	api := newFakeAPI(t)
+	api.Fail("/1.0/cloud/project", fakeFailure{code: http.StatusTooManyRequests, retryAfter: "1"})
+
+	rows, err := newTestQuery(t, api, "ovh_cloud_project", nil).MaxRetries(1).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, expected 2", len(rows))
+	}
+
+	// The fake API checks the signature of the retried request
+	headers := api.Headers()
+	first, err := strconv.ParseInt(headers[0].Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	retried, err := strconv.ParseInt(headers[1].Get("X-Ovh-Timestamp"), 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retried < first+1 {
+		t.Errorf("got timestamp %d for the retried request, expected at least %d", retried, first+1)
+	}
+	if headers[1].Get("X-Ovh-Signature") == headers[0].Get("X-Ovh-Signature") {
+		t.Error("expected the retried request to be signed again")
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	api := newFakeAPI(t)
+	api.Fail("/1.0/cloud/project", fakeFailure{code: http.StatusServiceUnavailable}, fakeFailure{code: http.StatusBadGateway})
+
+	rows, err := newTestQuery(t, api, "ovh_cloud_project", nil).MaxRetries(2).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, expected 2", len(rows))
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	api := newFakeAPI(t)
+	api.Fail("/1.0/cloud/project", fakeFailure{code: http.StatusServiceUnavailable}, fakeFailure{code: http.StatusServiceUnavailable})
+
+	_, err := newTestQuery(t, api, "ovh_cloud_project", nil).MaxRetries(1).List()
+	var apiErr *ovh.APIError
+	if !errors.As(err, &apiErr) || apiErr.Code != http.StatusServiceUnavailable {
+		t.Fatalf("got error %v, expected a 503 error", err)
+	}
+	if requests := api.Requests(); len(requests) != 2 {
+		t.Errorf("got requests %v, expected 2 attempts", requests)
+	}
+}
+
+func TestRetryNotIdempotent(t *testing.T) {
+	attempts := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	transport := &retryTransport{next: http.DefaultTransport, maxRetries: 3}
+	req, err := http.NewRequest(http.MethodPost, server.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusServiceUnavailable || attempts != 1 {
+		t.Errorf("got status %d after %d attempts, expected 503 after 1 attempt", resp.StatusCode, attempts)
+	}
+}
+
+func TestRetryDelay(t *testing.T) {
+	tests := []struct {
+		name       string
+		retryAfter string
+		attempt    int
+		min        time.Duration
+		max        time.Duration
+	}{
+		{"backoff", "", 0, minRetryDelay, minRetryDelay},
+		{"backoff of the third attempt", "", 2, 4 * minRetryDelay, 4 * minRetryDelay},
+		{"maximum backoff", "", 20, maxRetryDelay, maxRetryDelay},
+		{"seconds", "3", 0, 3 * time.Second, 3 * time.Second},
+		{"no delay", "0", 0, minRetryDelay, minRetryDelay},
+		{"too long", "3600", 0, maxRetryDelay, maxRetryDelay},
+		{"date", time.Now().Add(10 * time.Second).UTC().Format(http.TimeFormat), 0, 8 * time.Second, 10 * time.Second},
+		{"past date", time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), 0, minRetryDelay, minRetryDelay},
+		{"invalid", "soon", 1, 2 * minRetryDelay, 2 * minRetryDelay},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{}}
+			if test.retryAfter != "" {
+				resp.Header.Set("Retry-After", test.retryAfter)
+			}
+			if delay := retryDelay(resp, test.attempt); delay < test.min || delay > test.max {
+				t.Errorf("got delay %s, expected between %s and %s", delay, test.min, test.max)
+			}
+		})
+	}
+}
+
+func TestResign(t *testing.T) {
+	transport := &retryTransport{appSecret: testApplicationSecret, consumerKey: testConsumerKey}
+	req, err := http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/1.0/me", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("X-Ovh-Timestamp", "1700000000")
+	req.Header.Set("X-Ovh-Signature", signature(testApplicationSecret, testConsumerKey, http.MethodGet, req.URL.String(), "", 1700000000))
+
+	resigned := transport.resign(req, 3*time.Second)
+	if timestamp := resigned.Header.Get("X-Ovh-Timestamp"); timestamp != "1700000003" {
+		t.Errorf("got timestamp %s, expected 1700000003", timestamp)
+	}
+	if expected := signature(testApplicationSecret, testConsumerKey, http.MethodGet, req.URL.String(), "", 1700000003); resigned.Header.Get("X-Ovh-Signature") != expected {
+		t.Errorf("got signature %s, expected %s", resigned.Header.Get("X-Ovh-Signature"), expected)
+	}
+	if req.Header.Get("X-Ovh-Timestamp") != "1700000000" {
+		t.Error("expected the original request to be left unchanged")
+	}
+
+	// Requests which are not signed, e.g. with OAuth2, are not changed
+	unsigned, err := http.NewRequest(http.MethodGet, "https://eu.api.ovh.com/1.0/me", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transport.resign(unsigned, time.Second) != unsigned {
+		t.Error("expected the unsigned request to be returned as is")
+	}
+}
@@ -0,0 +1,178 @@
+package ovh
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhApiRequest() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_api_request",
+		Description: "Response of a GET request to any path of the OVH API.",
+		List: &plugin.ListConfig{
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "path"},
+				{Name: "query_params", Require: plugin.Optional},
+			},
+			Hydrate: listApiRequest,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "Path of the request (e.g. /vps or /v2/iam/resource).",
+			},
+			{
+				Name:        "query_params",
+				Type:        proto.ColumnType_JSON,
+				Description: "Query string parameters of the request, as an object.",
+				Transform:   transform.FromField("QueryParams"),
+			},
+			{
+				Name:        "status_code",
+				Type:        proto.ColumnType_INT,
+				Description: "HTTP status code of the response.",
+			},
+			{
+				Name:        "response",
+				Type:        proto.ColumnType_JSON,
+				Description: "Response of the request, or one of its elements when it is an array.",
+				Transform:   transform.FromField("Response").Transform(jsonString),
+			},
+			{
+				Name:        "error",
+				Type:        proto.ColumnType_STRING,
+				Description: "Error returned by the API.",
+			},
+			titleColumn(nil, "Path"),
+		},
+	}
+}
+
+type ApiRequest struct {
+	Path        string
+	QueryParams map[string]interface{}
+	StatusCode  int
+	Response    interface{}
+	Error       string
+}
+
+func listApiRequest(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	request := ApiRequest{
+		Path: d.EqualsQuals["path"].GetStringValue(),
+	}
+	if !strings.HasPrefix(request.Path, "/") {
+		return nil, fmt.Errorf("path must start with /: %s", request.Path)
+	}
+
+	target := request.Path
+	if qual, ok := d.EqualsQuals["query_params"]; ok {
+		decoder := json.NewDecoder(strings.NewReader(qual.GetJsonbValue()))
+		decoder.UseNumber()
+		if err := decoder.Decode(&request.QueryParams); err != nil {
+			return nil, fmt.Errorf("query_params must be a JSON object: %w", err)
+		}
+		target = withQueryParams(target, request.QueryParams)
+	}
+
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_api_request.listApiRequest", "connection_error", err)
+		return nil, err
+	}
+
+	req, err := client.NewRequest(http.MethodGet, target, nil, true)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_api_request.listApiRequest", err)
+		return nil, err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_api_request.listApiRequest", err)
+		return nil, err
+	}
+	request.StatusCode = resp.StatusCode
+
+	// Errors of the API are returned as a row, to be inspected like the
+	// responses.
+	var response interface{}
+	err = client.UnmarshalResponse(resp, &response)
+	var apiErr *ovh.APIError
+	if errors.As(err, &apiErr) {
+		request.Error = apiErr.Error()
+		d.StreamListItem(ctx, request)
+		return nil, nil
+	}
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_api_request.listApiRequest", err)
+		return nil, err
+	}
+
+	elements, ok := response.([]interface{})
+	if !ok {
+		request.Response = response
+		d.StreamListItem(ctx, request)
+		return nil, nil
+	}
+	for _, element := range elements {
+		request.Response = element
+		d.StreamListItem(ctx, request)
+		if rowsRemaining(ctx, d) == 0 {
+			break
+		}
+	}
+
+	return nil, nil
+}
+
+// withQueryParams appends the params to the query string of path. Arrays
+// are sent as repeated params.
+func withQueryParams(path string, params map[string]interface{}) string {
+	if len(params) == 0 {
+		return path
+	}
+	values := url.Values{}
+	for key, value := range params {
+		if elements, ok := value.([]interface{}); ok {
+			for _, element := range elements {
+				values.Add(key, queryParamValue(element))
+			}
+		} else {
+			values.Add(key, queryParamValue(value))
+		}
+	}
+
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + values.Encode()
+}
+
+// jsonString encodes the strings of a JSON column, which the SDK otherwise
+// takes as raw JSON.
+func jsonString(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	if s, ok := d.Value.(string); ok {
+		b, err := json.Marshal(s)
+		return string(b), err
+	}
+	return d.Value, nil
+}
+
+func queryParamValue(value interface{}) string {
+	if s, ok := value.(string); ok {
+		return s
+	}
+	b, _ := json.Marshal(value)
+	return string(b)
+}
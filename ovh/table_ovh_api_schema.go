@@ -0,0 +1,195 @@
+package ovh
+
+import (
+	"context"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhApiSchema() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_api_schema",
+		Description: "Operations of the OVH API, read from the description published by the API.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"api_version", "api"}),
+			Hydrate:    listApiSchema,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "api_version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of the API (1.0 or v2).",
+				Transform:   transform.FromField("ApiVersion"),
+			},
+			{
+				Name:        "api",
+				Type:        proto.ColumnType_STRING,
+				Description: "Root path of the API of the operation (e.g. /vps or /v2/iam).",
+				Transform:   transform.FromField("Api"),
+			},
+			{
+				Name:        "path",
+				Type:        proto.ColumnType_STRING,
+				Description: "Path of the operation (e.g. /vps/{serviceName} or /v2/iam/policy).",
+			},
+			{
+				Name:        "http_method",
+				Type:        proto.ColumnType_STRING,
+				Description: "HTTP method of the operation.",
+				Transform:   transform.FromField("HttpMethod"),
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the operation.",
+			},
+			{
+				Name:        "api_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the operation (PRODUCTION, BETA, ALPHA or DEPRECATED).",
+				Transform:   transform.FromField("ApiStatus"),
+			},
+			{
+				Name:        "api_status_description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the status of the operation, like the date of removal of the deprecated operations.",
+				Transform:   transform.FromField("ApiStatusDescription"),
+			},
+			{
+				Name:        "parameters",
+				Type:        proto.ColumnType_JSON,
+				Description: "Parameters of the operation, with their name, type and kind (path, query, header or body).",
+			},
+			{
+				Name:        "response_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the response of the operation (e.g. vps.VPS or string[]).",
+			},
+			{
+				Name:        "no_authentication",
+				Type:        proto.ColumnType_BOOL,
+				Description: "True if the operation can be called without authentication.",
+			},
+			{
+				Name:        "iam_actions",
+				Type:        proto.ColumnType_JSON,
+				Description: "IAM actions needed to call the operation.",
+				Transform:   transform.FromField("IamActions"),
+			},
+			titleColumn(nil, "Path"),
+		},
+	}
+}
+
+type ApiSchemaOperation struct {
+	ApiVersion           string
+	Api                  string
+	Path                 string
+	HttpMethod           string
+	Description          string
+	ApiStatus            string
+	ApiStatusDescription string
+	Parameters           []apiSchemaParameter
+	ResponseType         string
+	NoAuthentication     bool
+	IamActions           []apiSchemaIAMAction
+}
+
+func listApiSchema(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	loader, err := getAPISchemaLoader(d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_api_schema.listApiSchema", "connection_error", err)
+		return nil, err
+	}
+
+	versions := []string{"1.0", "v2"}
+	if version := d.EqualsQuals["api_version"].GetStringValue(); version != "" {
+		versions = []string{version}
+	}
+
+	for _, version := range versions {
+		var apis []string
+		if api := d.EqualsQuals["api"].GetStringValue(); api != "" {
+			apiVersion, root := splitAPIPath(api)
+			if apiVersion != version {
+				continue
+			}
+			apis = []string{root}
+		} else {
+			apis, err = loader.apis(version)
+			if err != nil {
+				plugin.Logger(ctx).Error("ovh_api_schema.listApiSchema", err)
+				return nil, err
+			}
+		}
+
+		for _, api := range apis {
+			schema, err := loader.load("/" + version + api)
+			if err != nil {
+				plugin.Logger(ctx).Error("ovh_api_schema.listApiSchema", err)
+				return nil, err
+			}
+			if schema == nil {
+				continue
+			}
+			for _, path := range schema.APIs {
+				for _, operation := range path.Operations {
+					d.StreamListItem(ctx, ApiSchemaOperation{
+						ApiVersion:           version,
+						Api:                  versionedPath(version, api),
+						Path:                 versionedPath(version, path.Path),
+						HttpMethod:           operation.HTTPMethod,
+						Description:          operation.Description,
+						ApiStatus:            operation.APIStatus.Value,
+						ApiStatusDescription: operation.APIStatus.Description,
+						Parameters:           operation.Parameters,
+						ResponseType:         operation.ResponseType,
+						NoAuthentication:     operation.NoAuthentication,
+						IamActions:           operation.IAMActions,
+					})
+					if rowsRemaining(ctx, d) == 0 {
+						return nil, nil
+					}
+				}
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+// getAPISchemaLoader returns the loader of the descriptions of the API of the
+// connection, shared by the queries to download each description once.
+func getAPISchemaLoader(d *plugin.QueryData) (*apiSchemaLoader, error) {
+	config := GetConfig(d.Connection)
+	endpoint := ""
+	dir := ""
+	if config.Endpoint != nil {
+		endpoint = *config.Endpoint
+	}
+	if config.APISchemaDir != nil {
+		dir = *config.APISchemaDir
+	}
+	cacheKey := clientCacheKey(d.Connection.Name+"-api-schema", endpoint, dir)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(*apiSchemaLoader), nil
+	}
+
+	loader, err := newAPISchemaLoader(config)
+	if err != nil {
+		return nil, err
+	}
+	d.ConnectionManager.Cache.Set(cacheKey, loader)
+	return loader, nil
+}
+
+// versionedPath returns the path to call an operation of a version of the
+// API, the paths of v2 starting with /v2.
+func versionedPath(version, path string) string {
+	if version == "v2" {
+		return "/v2" + path
+	}
+	return path
+}
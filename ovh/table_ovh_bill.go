@@ -33,14 +33,20 @@ func tableOvhBill() *plugin.Table {
 		Name:        "ovh_bill",
 		Description: "Bills of your account.",
 		List: &plugin.ListConfig{
-			Hydrate: listBill,
+			Hydrate:    listBill,
+			KeyColumns: plugin.KeyColumnSlice{dateRangeKeyColumn("date")},
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"id"}),
-			Hydrate:    getBill,
+			KeyColumns:   plugin.AllColumns([]string{"id"}),
+			Hydrate:      getBill,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getBillInfo},
+			{
+				Func:           getBillInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -108,12 +114,17 @@ func tableOvhBill() *plugin.Table {
 				Transform:   transform.FromField("Tax.Value"),
 				Description: "Amount of the tax.",
 			},
+			titleColumn(nil, "ID"),
 		},
 	}
 }
 
 func getBillInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	bill := h.Item.(Bill)
+	// The get call already fetched the details
+	if !bill.Date.IsZero() {
+		return bill, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -132,14 +143,14 @@ func getBillInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 }
 
 func listBill(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_bill.listBill", "connection_error", err)
 		return nil, err
 	}
 
 	var billsId []string
-	err = client.Get("/me/bill", &billsId)
+	err = client.Get("/me/bill"+dateRangeQuery(d, "date", "date"), &billsId)
 
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_bill.listBill", err)
@@ -155,9 +166,10 @@ func listBill(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 	return nil, nil
 }
 
-func getBill(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getBill(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	id := d.EqualsQuals["id"].GetStringValue()
 	var bill Bill
 	bill.ID = id
-	return bill, nil
+	h.Item = bill
+	return getBillInfo(ctx, d, h)
 }
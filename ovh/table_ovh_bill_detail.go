@@ -24,16 +24,37 @@ type BillDetail struct {
 }
 
 func tableOvhBillDetails() *plugin.Table {
+	// The details are listed by bill: the bills of a range of dates are
+	// listed when no bill_id is given
+	date := dateRangeKeyColumn("date")
+	date.Require = plugin.AnyOf
+
 	return &plugin.Table{
 		Name:        "ovh_bill_detail",
 		Description: "Detail of a bill.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.AllColumns([]string{"bill_id"}),
-			Hydrate:    listBillingDetails,
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "bill_id", Require: plugin.AnyOf},
+				date,
+			},
+			Hydrate: listBillingDetails,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"bill_id", "id"}),
-			Hydrate:    getBillingDetail,
+			KeyColumns:   plugin.AllColumns([]string{"bill_id", "id"}),
+			Hydrate:      getBillingDetail,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getBillDetailInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getBillDetailBill,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -43,10 +64,17 @@ func tableOvhBillDetails() *plugin.Table {
 			},
 			{
 				Name:        "bill_id",
-				Transform:   transform.FromQual("bill_id"),
+				Transform:   transform.FromField("BillID"),
 				Type:        proto.ColumnType_STRING,
 				Description: "ID of bill.",
 			},
+			{
+				Name:        "date",
+				Hydrate:     getBillDetailBill,
+				Transform:   transform.FromField("Date"),
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Date of the bill.",
+			},
 			{
 				Name:        "description",
 				Hydrate:     getBillDetailInfo,
@@ -93,6 +121,7 @@ func tableOvhBillDetails() *plugin.Table {
 				Transform:   transform.FromField("UnitPrice.Value"),
 				Description: "Unit price of this detail.",
 			},
+			titleColumn(getBillDetailInfo, "Description"),
 		},
 	}
 }
@@ -131,29 +160,71 @@ func getBillDetailInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydra
 	return billDetail, nil
 }
 
-func listBillingDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+// getBillDetailBill returns the bill of a detail, for its date. The bills are
+// cached as they have many details, by connection as the cache is shared by
+// the connections of the plugin.
+func getBillDetailBill(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	billDetail := h.Item.(BillDetail)
+
+	cacheKey := fmt.Sprintf("ovh-bill-%s-%s", d.Connection.Name, billDetail.BillID)
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return cachedData.(Bill), nil
+	}
+
 	client, err := connect(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", "connection_error", err)
+		plugin.Logger(ctx).Error("ovh_bill_detail.getBillDetailBill", "connection_error", err)
 		return nil, err
 	}
 
-	billId := d.EqualsQuals["bill_id"].GetStringValue()
+	var bill Bill
+	err = client.Get(fmt.Sprintf("/me/bill/%s", billDetail.BillID), &bill)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_bill_detail.getBillDetailBill", err)
+		return nil, err
+	}
 
-	// First, we get IDs of billing
-	var billDetailsId []string
-	err = client.Get(fmt.Sprintf("/me/bill/%s/details", billId), &billDetailsId)
+	d.ConnectionManager.Cache.Set(cacheKey, bill)
+	return bill, nil
+}
 
+func listBillingDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", err)
+		plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", "connection_error", err)
 		return nil, err
 	}
 
-	for _, id := range billDetailsId {
-		d.StreamListItem(ctx, BillDetail{
-			ID:     id,
-			BillID: billId,
-		})
+	var billsId []string
+	if billId := d.EqualsQuals["bill_id"].GetStringValue(); billId != "" {
+		billsId = []string{billId}
+	} else {
+		err = client.Get("/me/bill"+dateRangeQuery(d, "date", "date"), &billsId)
+		if err != nil {
+			plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", err)
+			return nil, err
+		}
+	}
+
+	for _, billId := range billsId {
+		// First, we get IDs of billing
+		var billDetailsId []string
+		err = client.Get(fmt.Sprintf("/me/bill/%s/details", billId), &billDetailsId)
+
+		if err != nil {
+			plugin.Logger(ctx).Error("ovh_bill_detail.listBillingDetails", err)
+			return nil, err
+		}
+
+		for _, id := range billDetailsId {
+			d.StreamListItem(ctx, BillDetail{
+				ID:     id,
+				BillID: billId,
+			})
+			if rowsRemaining(ctx, d) == 0 {
+				return nil, nil
+			}
+		}
 	}
 
 	return nil, nil
@@ -40,11 +40,16 @@ func tableOvhCeph() *plugin.Table {
 			Hydrate: listCeph,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"id"}),
-			Hydrate:    getCeph,
+			KeyColumns:   plugin.AllColumns([]string{"id"}),
+			Hydrate:      getCeph,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getCephInfo},
+			{
+				Func:           getCephInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -112,12 +117,19 @@ func tableOvhCeph() *plugin.Table {
 				Transform:   transform.FromField("Status"),
 			},
 
+			titleColumn(getCephInfo, "Iam.DisplayName"),
+			akasColumn(getCephInfo, "Iam.URN"),
+			tagsColumn(getCephInfo, "Iam.Tags"),
 		},
 	}
 }
 
 func getCephInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	ceph := h.Item.(Ceph)
+	// The get call already fetched the details
+	if ceph.CreateDate != "" {
+		return ceph, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -136,7 +148,7 @@ func getCephInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData
 }
 
 func listCeph(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_ceph.listCeph", "connection_error", err)
 		return nil, err
@@ -159,9 +171,10 @@ func listCeph(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 	return nil, nil
 }
 
-func getCeph(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getCeph(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	id := d.EqualsQuals["id"].GetStringValue()
 	var ceph Ceph
 	ceph.ID = id
-	return ceph, nil
+	h.Item = ceph
+	return getCephInfo(ctx, d, h)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,19 @@ func tableOvhCloudAIApp() *plugin.Table {
 		Name:        "ovh_cloud_ai_app",
 		Description: "OVHcloud AI Deploy lets you easily deploy machine learning models and applications to production, create your API access points effortlessly, and make effective predictions.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listAIApp,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getAIApp,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getAIApp,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -75,11 +77,13 @@ func tableOvhCloudAIApp() *plugin.Table {
 				Transform:   transform.FromField("Status.URL"),
 				Description: "Access URL of the app.",
 			},
+			titleColumn(nil, "Spec.Name"),
 		},
 	}
 }
 
 type AIApp struct {
+	ProjectID string      `json:"-"`
 	ID        string      `json:"id"`
 	CreatedAt time.Time   `json:"createdAt"`
 	Spec      AIAppSpec   `json:"spec"`
@@ -98,39 +102,46 @@ type AIAppStatus struct {
 	AvailableReplicas int    `json:"availableReplicas"`
 }
 
-func getAIApp(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	app := h.Item.(AIApp)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-
+func getAIApp(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_app.getAIApp", "connection_error", err)
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/app/%s", projectId, app.ID), &app)
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var app AIApp
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/app/%s", projectId, id), &app)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_app.getAIApp", err)
 		return nil, err
 	}
+	app.ProjectID = projectId
 	return app, nil
 }
 
 func listAIApp(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_app.listAIApp", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var apps []AIApp
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/app", projectId), &apps)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var apps []AIApp
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/ai/app", projectId), &apps)
+		if err != nil {
+			return err
+		}
+		for _, app := range apps {
+			app.ProjectID = projectId
+			d.StreamListItem(ctx, app)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_app.listAIApp", err)
 		return nil, err
 	}
-	for _, app := range apps {
-		d.StreamListItem(ctx, app)
-	}
 	return nil, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,19 @@ func tableOvhCloudAIJob() *plugin.Table {
 		Name:        "ovh_cloud_ai_job",
 		Description: "OVHcloud AI Training lets you train your AI, machine learning and deep learning models efficiently and easily, and optimise your GPU usage.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listAIJob,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getAIJob,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getAIJob,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -69,11 +71,13 @@ func tableOvhCloudAIJob() *plugin.Table {
 				Transform:   transform.FromField("Status.URL"),
 				Description: "Access URL of the job.",
 			},
+			titleColumn(nil, "Spec.Name"),
 		},
 	}
 }
 
 type AIJob struct {
+	ProjectID string      `json:"-"`
 	ID        string      `json:"id"`
 	CreatedAt time.Time   `json:"createdAt"`
 	Spec      AIJobSpec   `json:"spec"`
@@ -91,39 +95,46 @@ type AIJobStatus struct {
 	State string `json:"state"`
 }
 
-func getAIJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	job := h.Item.(AIJob)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-
+func getAIJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_job.getAIJob", "connection_error", err)
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/job/%s", projectId, job.ID), &job)
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var job AIJob
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/job/%s", projectId, id), &job)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_job.getAIJob", err)
 		return nil, err
 	}
+	job.ProjectID = projectId
 	return job, nil
 }
 
 func listAIJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_job.listAIJob", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var jobs []AIJob
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/job", projectId), &jobs)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var jobs []AIJob
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/ai/job", projectId), &jobs)
+		if err != nil {
+			return err
+		}
+		for _, job := range jobs {
+			job.ProjectID = projectId
+			d.StreamListItem(ctx, job)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_job.listAIJob", err)
 		return nil, err
 	}
-	for _, job := range jobs {
-		d.StreamListItem(ctx, job)
-	}
 	return nil, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,19 @@ func tableOvhCloudAINotebook() *plugin.Table {
 		Name:        "ovh_cloud_ai_notebook",
 		Description: "OVHcloud AI Notebook gives a quick and simple start launching your Jupyter or VS Code notebooks in the cloud.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listAINotebook,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getAINotebook,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getAINotebook,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -81,11 +83,13 @@ func tableOvhCloudAINotebook() *plugin.Table {
 				Transform:   transform.FromField("Status.URL"),
 				Description: "Access URL of the notebook.",
 			},
+			titleColumn(nil, "Spec.Name"),
 		},
 	}
 }
 
 type AINotebook struct {
+	ProjectID string           `json:"-"`
 	ID        string           `json:"id"`
 	CreatedAt time.Time        `json:"createdAt"`
 	Spec      AINotebookSpec   `json:"spec"`
@@ -109,39 +113,46 @@ type AINotebookStatus struct {
 	State string `json:"state"`
 }
 
-func getAINotebook(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
-	notebook := h.Item.(AINotebook)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-
+func getAINotebook(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
 	client, err := connect(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_notebook.getAINotebook", "connection_error", err)
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/notebook/%s", projectId, notebook.ID), &notebook)
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var notebook AINotebook
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/notebook/%s", projectId, id), &notebook)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_notebook.getAINotebook", err)
 		return nil, err
 	}
+	notebook.ProjectID = projectId
 	return notebook, nil
 }
 
 func listAINotebook(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_notebook.listAINotebook", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var notebooks []AINotebook
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/ai/notebook", projectId), &notebooks)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var notebooks []AINotebook
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/ai/notebook", projectId), &notebooks)
+		if err != nil {
+			return err
+		}
+		for _, notebook := range notebooks {
+			notebook.ProjectID = projectId
+			d.StreamListItem(ctx, notebook)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ai_notebook.listAINotebook", err)
 		return nil, err
 	}
-	for _, notebook := range notebooks {
-		d.StreamListItem(ctx, notebook)
-	}
 	return nil, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,21 +16,26 @@ func tableOvhCloudDataJob() *plugin.Table {
 		Name:        "ovh_cloud_data_job",
 		Description: "A data job is processed by OVH by Apache Spark.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listDataJob,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getDataJob,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getDataJob,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getDataJobInfo},
+			{
+				Func:           getDataJobInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -100,11 +106,13 @@ func tableOvhCloudDataJob() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Maximum 'Time To Live' (in RFC3339 (duration)) of this job, after which it will be automatically terminated.",
 			},
+			titleColumn(getDataJobInfo, "Name"),
 		},
 	}
 }
 
 type Job struct {
+	ProjectID     string    `json:"-"`
 	ID            string    `json:"id"`
 	Name          string    `json:"name"`
 	Region        string    `json:"region"`
@@ -120,7 +128,10 @@ type Job struct {
 
 func getDataJobInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	job := h.Item.(Job)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	// The get call already fetched the details
+	if !job.CreationDate.IsZero() {
+		return job, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -128,7 +139,7 @@ func getDataJobInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", projectId, job.ID), &job)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs/%s", job.ProjectID, job.ID), &job)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_data_job.getDataJobInfo", err)
 		return nil, err
@@ -137,29 +148,37 @@ func getDataJobInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 }
 
 func listDataJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_data_job.listDataJobInfo", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var jobIds []string
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs", projectId), &jobIds)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var jobIds []string
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/dataProcessing/jobs", projectId), &jobIds)
+		if err != nil {
+			return err
+		}
+		for _, jobId := range jobIds {
+			var job Job
+			job.ProjectID = projectId
+			job.ID = jobId
+			d.StreamListItem(ctx, job)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_data_job.listDataJobInfo", err)
 		return nil, err
 	}
-	for _, jobId := range jobIds {
-		var job Job
-		job.ID = jobId
-		d.StreamListItem(ctx, job)
-	}
 	return nil, nil
 }
 
-func getDataJob(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getDataJob(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	id := d.EqualsQuals["id"].GetStringValue()
 	var job Job
+	job.ProjectID = d.EqualsQuals["project_id"].GetStringValue()
 	job.ID = id
-	return job, nil
+	h.Item = job
+	return getDataJobInfo(ctx, d, h)
 }
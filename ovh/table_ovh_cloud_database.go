@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,26 @@ func tableOvhCloudDatabase() *plugin.Table {
 		Name:        "ovh_cloud_database",
 		Description: "An hosted database service.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listDatabase,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getDatabase,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getDatabase,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getDatabaseInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -100,12 +109,14 @@ func tableOvhCloudDatabase() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Time on which maintenances can start every day.",
 			},
+			titleColumn(getDatabaseInfo, "Description"),
 		},
 	}
 
 }
 
 type Database struct {
+	ProjectID       string     `json:"-"`
 	ID              string     `json:"id"`
 	CreatedAt       *time.Time `json:"createdAt"`
 	Plan            string     `json:"plan"`
@@ -122,7 +133,10 @@ type Database struct {
 
 func getDatabaseInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	database := h.Item.(Database)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	// The get call already fetched the details
+	if database.CreatedAt != nil {
+		return database, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -130,7 +144,7 @@ func getDatabaseInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/service/%s", projectId, database.ID), &database)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/service/%s", database.ProjectID, database.ID), &database)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_database.getDatabaseInfo", err)
 		return nil, err
@@ -139,29 +153,37 @@ func getDatabaseInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 }
 
 func listDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_database.listDatabaseInfo", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var databaseIds []string
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/service", projectId), &databaseIds)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var databaseIds []string
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/database/service", projectId), &databaseIds)
+		if err != nil {
+			return err
+		}
+		for _, databaseId := range databaseIds {
+			var database Database
+			database.ProjectID = projectId
+			database.ID = databaseId
+			d.StreamListItem(ctx, database)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_database.listDatabaseInfo", err)
 		return nil, err
 	}
-	for _, databaseId := range databaseIds {
-		var database Database
-		database.ID = databaseId
-		d.StreamListItem(ctx, database)
-	}
 	return nil, nil
 }
 
-func getDatabase(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getDatabase(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	id := d.EqualsQuals["id"].GetStringValue()
 	var database Database
+	database.ProjectID = d.EqualsQuals["project_id"].GetStringValue()
 	database.ID = id
-	return database, nil
+	h.Item = database
+	return getDatabaseInfo(ctx, d, h)
 }
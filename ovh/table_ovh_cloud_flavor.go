@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -14,18 +15,19 @@ func tableOvhCloudFlavor() *plugin.Table {
 		Name:        "ovh_cloud_flavor",
 		Description: "A flavor is the instance model defining its characteristics in terms of resources.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listFlavor,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getFlavor,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getFlavor,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -103,6 +105,7 @@ func tableOvhCloudFlavor() *plugin.Table {
 				Description: "Plan code to order hourly instance",
 				Transform:   transform.FromField("PlanCodes.Hourly"),
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
@@ -113,6 +116,7 @@ type PlanCodes struct {
 }
 
 type Flavor struct {
+	ProjectID         string    `json:"-"`
 	ID                string    `json:"id"`
 	Name              string    `json:"name"`
 	Region            string    `json:"region"`
@@ -129,21 +133,27 @@ type Flavor struct {
 }
 
 func listFlavor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_flavor.listFlavor", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var flavors []Flavor
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/flavor", projectId), &flavors)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var flavors []Flavor
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/flavor", projectId), &flavors)
+		if err != nil {
+			return err
+		}
+		for _, flavor := range flavors {
+			flavor.ProjectID = projectId
+			d.StreamListItem(ctx, flavor)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_flavor.listFlavor", err)
 		return nil, err
 	}
-	for _, flavor := range flavors {
-		d.StreamListItem(ctx, flavor)
-	}
 	return nil, nil
 }
 
@@ -161,5 +171,6 @@ func getFlavor(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		plugin.Logger(ctx).Error("ovh_cloud_flavor.getFlavor", err)
 		return nil, err
 	}
+	flavor.ProjectID = projectId
 	return flavor, nil
 }
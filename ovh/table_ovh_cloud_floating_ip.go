@@ -0,0 +1,131 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudFloatingIP() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_floating_ip",
+		Description: "A floating IP of a cloud project, exposing a private IP through a gateway.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "region"}),
+			Hydrate:    listFloatingIP,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "region", "id"}),
+			Hydrate:      getFloatingIP,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the floating IP.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Floating IP ID.",
+			},
+			{
+				Name:        "ip",
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("IP"),
+				Description: "Public IP.",
+			},
+			{
+				Name:        "network_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkID"),
+				Description: "ID of the public network of the IP.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Floating IP status (active, down, error).",
+			},
+			{
+				Name:        "associated_entity",
+				Type:        proto.ColumnType_JSON,
+				Description: "Port (instance or load balancer) the IP is associated to, with its private IP and gateway, null when the IP is not associated.",
+			},
+			titleColumn(nil, "IP"),
+		},
+	}
+}
+
+type FloatingIP struct {
+	ProjectID        string                      `json:"-"`
+	Region           string                      `json:"region"`
+	ID               string                      `json:"id"`
+	IP               string                      `json:"ip"`
+	NetworkID        string                      `json:"networkId"`
+	Status           string                      `json:"status"`
+	AssociatedEntity *FloatingIPAssociatedEntity `json:"associatedEntity"`
+}
+
+type FloatingIPAssociatedEntity struct {
+	ID        string `json:"id"`
+	Type      string `json:"type"`
+	IP        string `json:"ip"`
+	GatewayID string `json:"gatewayId"`
+}
+
+func listFloatingIP(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_floating_ip.listFloatingIP", "connection_error", err)
+		return nil, err
+	}
+	err = forEachRegion(ctx, d, client, func(client *ovh.Client, projectId, regionName string) error {
+		var floatingIPs []FloatingIP
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/floatingip", projectId, regionName), &floatingIPs)
+		if err != nil {
+			return err
+		}
+		for _, floatingIP := range floatingIPs {
+			floatingIP.ProjectID = projectId
+			floatingIP.Region = regionName
+			d.StreamListItem(ctx, floatingIP)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_floating_ip.listFloatingIP", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getFloatingIP(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_floating_ip.getFloatingIP", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	regionName := d.EqualsQuals["region"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var floatingIP FloatingIP
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/floatingip/%s", projectId, regionName, id), &floatingIP)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_floating_ip.getFloatingIP", err)
+		return nil, err
+	}
+	floatingIP.ProjectID = projectId
+	floatingIP.Region = regionName
+	return floatingIP, nil
+}
@@ -0,0 +1,146 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudGateway() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_gateway",
+		Description: "A gateway routing a private network of a cloud project to the internet.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "region"}),
+			Hydrate:    listGateway,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "region", "id"}),
+			Hydrate:      getGateway,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the gateway.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Gateway ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Gateway name.",
+			},
+			{
+				Name:        "model",
+				Type:        proto.ColumnType_STRING,
+				Description: "Size of the gateway (s, m, l...).",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Gateway status (active, building, error...).",
+			},
+			{
+				Name:        "external_information",
+				Type:        proto.ColumnType_JSON,
+				Description: "Public network and IPs of the gateway.",
+			},
+			{
+				Name:        "interfaces",
+				Type:        proto.ColumnType_JSON,
+				Description: "Interfaces of the gateway in the private networks.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type Gateway struct {
+	ProjectID           string                      `json:"-"`
+	Region              string                      `json:"region"`
+	ID                  string                      `json:"id"`
+	Name                string                      `json:"name"`
+	Model               string                      `json:"model"`
+	Status              string                      `json:"status"`
+	ExternalInformation *GatewayExternalInformation `json:"externalInformation"`
+	Interfaces          []GatewayInterface          `json:"interfaces"`
+}
+
+type GatewayExternalInformation struct {
+	NetworkID string    `json:"networkId"`
+	IPs       []FixedIP `json:"ips"`
+}
+
+type GatewayInterface struct {
+	ID        string `json:"id"`
+	IP        string `json:"ip"`
+	NetworkID string `json:"networkId"`
+	SubnetID  string `json:"subnetId"`
+}
+
+// FixedIP is an IP of a port of a network, on a gateway or an instance.
+type FixedIP struct {
+	IP       string `json:"ip"`
+	SubnetID string `json:"subnetId"`
+}
+
+func listGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_gateway.listGateway", "connection_error", err)
+		return nil, err
+	}
+	err = forEachRegion(ctx, d, client, func(client *ovh.Client, projectId, regionName string) error {
+		var gateways []Gateway
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/gateway", projectId, regionName), &gateways)
+		if err != nil {
+			return err
+		}
+		for _, gateway := range gateways {
+			gateway.ProjectID = projectId
+			gateway.Region = regionName
+			d.StreamListItem(ctx, gateway)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_gateway.listGateway", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getGateway(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_gateway.getGateway", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	regionName := d.EqualsQuals["region"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var gateway Gateway
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/gateway/%s", projectId, regionName, id), &gateway)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_gateway.getGateway", err)
+		return nil, err
+	}
+	gateway.ProjectID = projectId
+	gateway.Region = regionName
+	return gateway, nil
+}
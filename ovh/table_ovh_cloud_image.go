@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,19 @@ func tableOvhCloudImage() *plugin.Table {
 		Name:        "ovh_cloud_image",
 		Description: "An image is a pre-installed, ready-to-use operating system.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listImage,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getImage,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getImage,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -102,11 +104,13 @@ func tableOvhCloudImage() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Order plan code.",
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
 
 type Image struct {
+	ProjectID    string    `json:"-"`
 	ID           string    `json:"id"`
 	Name         string    `json:"name"`
 	Region       string    `json:"region"`
@@ -124,21 +128,27 @@ type Image struct {
 }
 
 func listImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_image.listImage", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var images []Image
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/image", projectId), &images)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var images []Image
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/image", projectId), &images)
+		if err != nil {
+			return err
+		}
+		for _, image := range images {
+			image.ProjectID = projectId
+			d.StreamListItem(ctx, image)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_image.listImage", err)
 		return nil, err
 	}
-	for _, image := range images {
-		d.StreamListItem(ctx, image)
-	}
 	return nil, nil
 }
 
@@ -156,5 +166,6 @@ func getImage(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (
 		plugin.Logger(ctx).Error("ovh_cloud_image.getImage", err)
 		return nil, err
 	}
+	image.ProjectID = projectId
 	return image, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,19 @@ func tableOvhCloudInstance() *plugin.Table {
 		Name:        "ovh_cloud_instance",
 		Description: "An instance is a virtual server in the OVH cloud.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listInstance,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getInstance,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getInstance,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -80,42 +82,65 @@ func tableOvhCloudInstance() *plugin.Table {
 				Type:        proto.ColumnType_INT,
 				Description: "Instance outgoing network traffic for the current month (in bytes).",
 			},
+			{
+				Name:        "ip_addresses",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IPAddresses"),
+				Description: "IPs of the instance, with their type (public or private), version and network.",
+			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
 
 type Instance struct {
-	ID                          string    `json:"id"`
-	Name                        string    `json:"name"`
-	FlavorID                    string    `json:"flavorId"`
-	Flavor                      Flavor    `json:"flavor"`
-	ImageID                     string    `json:"imageId"`
-	Image                       Image     `json:"image"`
-	SSHKeyID                    string    `json:"sshKeyId"`
-	SSHKey                      SshKey    `json:"sshKey"`
-	Created                     time.Time `json:"created"`
-	Region                      string    `json:"region"`
-	Status                      string    `json:"status"`
-	PlanCode                    string    `json:"planCode"`
-	CurrentMonthOutgoingTraffic *int      `json:"currentMonthOutgoingTraffic,omitempty"`
+	ProjectID                   string              `json:"-"`
+	ID                          string              `json:"id"`
+	Name                        string              `json:"name"`
+	FlavorID                    string              `json:"flavorId"`
+	Flavor                      Flavor              `json:"flavor"`
+	ImageID                     string              `json:"imageId"`
+	Image                       Image               `json:"image"`
+	SSHKeyID                    string              `json:"sshKeyId"`
+	SSHKey                      SshKey              `json:"sshKey"`
+	Created                     time.Time           `json:"created"`
+	Region                      string              `json:"region"`
+	Status                      string              `json:"status"`
+	PlanCode                    string              `json:"planCode"`
+	CurrentMonthOutgoingTraffic *int                `json:"currentMonthOutgoingTraffic,omitempty"`
+	IPAddresses                 []InstanceIPAddress `json:"ipAddresses"`
+}
+
+type InstanceIPAddress struct {
+	IP        string `json:"ip"`
+	Type      string `json:"type"`
+	Version   int    `json:"version"`
+	NetworkID string `json:"networkId"`
+	GatewayIP string `json:"gatewayIp"`
 }
 
 func listInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_instance.listInstance", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var instances []Instance
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/instance", projectId), &instances)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var instances []Instance
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/instance", projectId), &instances)
+		if err != nil {
+			return err
+		}
+		for _, instance := range instances {
+			instance.ProjectID = projectId
+			d.StreamListItem(ctx, instance)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_instance.listInstance", err)
 		return nil, err
 	}
-	for _, instance := range instances {
-		d.StreamListItem(ctx, instance)
-	}
 	return nil, nil
 }
 
@@ -136,5 +161,6 @@ func getInstance(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	instance.ImageID = instance.Image.ID
 	instance.FlavorID = instance.Flavor.ID
 	instance.SSHKeyID = instance.SSHKey.ID
+	instance.ProjectID = projectId
 	return instance, nil
 }
@@ -0,0 +1,147 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudInstanceInterface() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_instance_interface",
+		Description: "A network interface of an instance, attached to the public network or to a private network.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "instance_id"}),
+			Hydrate:    listInstanceInterface,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "instance_id", "id"}),
+			Hydrate:      getInstanceInterface,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "instance_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceID"),
+				Description: "Instance ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interface ID.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the network of the interface (public or private).",
+			},
+			{
+				Name:        "network_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkID"),
+				Description: "OpenStack ID of the network of the interface (see the regions of ovh_cloud_network_private and ovh_cloud_network_public).",
+			},
+			{
+				Name:        "mac_address",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("MACAddress"),
+				Description: "MAC address of the interface.",
+			},
+			{
+				Name:        "state",
+				Type:        proto.ColumnType_STRING,
+				Description: "Interface state (ACTIVE, BUILD, DOWN).",
+			},
+			{
+				Name:        "fixed_ips",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FixedIPs"),
+				Description: "IPs of the interface with their subnet.",
+			},
+			titleColumn(nil, "ID"),
+		},
+	}
+}
+
+type InstanceInterface struct {
+	ProjectID  string    `json:"-"`
+	InstanceID string    `json:"-"`
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	NetworkID  string    `json:"networkId"`
+	MACAddress string    `json:"macAddress"`
+	State      string    `json:"state"`
+	FixedIPs   []FixedIP `json:"fixedIps"`
+}
+
+func listInstanceInterface(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_instance_interface.listInstanceInterface", "connection_error", err)
+		return nil, err
+	}
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var instances []Instance
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/instance", projectId), &instances)
+		if err != nil {
+			return err
+		}
+		qualInstanceId := d.EqualsQuals["instance_id"].GetStringValue()
+		for _, instance := range instances {
+			if qualInstanceId != "" && instance.ID != qualInstanceId {
+				continue
+			}
+			var interfaces []InstanceInterface
+			err := client.Get(fmt.Sprintf("/cloud/project/%s/instance/%s/interface", projectId, instance.ID), &interfaces)
+			if err != nil {
+				// The instances deleted while being listed are skipped
+				if ShouldIgnoreError(ctx, d, nil, err) {
+					continue
+				}
+				return err
+			}
+			for _, instanceInterface := range interfaces {
+				instanceInterface.ProjectID = projectId
+				instanceInterface.InstanceID = instance.ID
+				d.StreamListItem(ctx, instanceInterface)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_instance_interface.listInstanceInterface", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getInstanceInterface(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_instance_interface.getInstanceInterface", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	instanceId := d.EqualsQuals["instance_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var instanceInterface InstanceInterface
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/instance/%s/interface/%s", projectId, instanceId, id), &instanceInterface)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_instance_interface.getInstanceInterface", err)
+		return nil, err
+	}
+	instanceInterface.ProjectID = projectId
+	instanceInterface.InstanceID = instanceId
+	return instanceInterface, nil
+}
@@ -0,0 +1,350 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudKubeCluster() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube_cluster",
+		Description: "A Managed Kubernetes Service (MKS) cluster of a cloud project.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:    listKubeCluster,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getKubeCluster,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getKubeClusterInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getKubeClusterOpenIDConnect,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getKubeClusterIPRestrictions,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Cluster ID.",
+			},
+			{
+				Name:        "name",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Cluster name.",
+			},
+			{
+				Name:        "region",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the cluster.",
+			},
+			{
+				Name:        "status",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Cluster status (READY, INSTALLING, UPDATING, ERROR...).",
+			},
+			{
+				Name:        "version",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Kubernetes version of the cluster (e.g. 1.30).",
+			},
+			{
+				Name:        "next_upgrade_versions",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Kubernetes versions available to upgrade the cluster.",
+			},
+			{
+				Name:        "is_up_to_date",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsUpToDate"),
+				Description: "True if all the nodes and the control plane are up to date.",
+			},
+			{
+				Name:        "control_plane_is_up_to_date",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("ControlPlaneIsUpToDate"),
+				Description: "True if the control plane is up to date.",
+			},
+			{
+				Name:        "update_policy",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Update policy of the cluster (ALWAYS_UPDATE, MINIMAL_DOWNTIME or NEVER_UPDATE).",
+			},
+			{
+				Name:        "url",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "URL of the API server of the cluster.",
+			},
+			{
+				Name:        "nodes_url",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "URL of the nodes of the cluster.",
+			},
+			{
+				Name:        "kube_proxy_mode",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Mode of kube-proxy (iptables or ipvs).",
+			},
+			{
+				Name:        "customization",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Customization of the API server (admission plugins) and of kube-proxy.",
+			},
+			{
+				Name:        "private_network_id",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "OpenStack ID of the private network of the cluster.",
+			},
+			{
+				Name:        "private_network_configuration",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Configuration of the private network (default vRack gateway and routing).",
+			},
+			{
+				Name:        "nodes_subnet_id",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "OpenStack ID of the subnet of the nodes.",
+			},
+			{
+				Name:        "load_balancers_subnet_id",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "OpenStack ID of the subnet of the load balancers.",
+			},
+			{
+				Name:        "audit_logs_subscribed",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AuditLogsSubscribed"),
+				Description: "True if the audit logs are forwarded to Logs Data Platform.",
+			},
+			{
+				Name:        "created_at",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Cluster creation date.",
+			},
+			{
+				Name:        "updated_at",
+				Hydrate:     getKubeClusterInfo,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the cluster.",
+			},
+			{
+				Name:        "open_id_connect",
+				Hydrate:     getKubeClusterOpenIDConnect,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+				Description: "OIDC configuration of the API server, null when the authentication by OIDC is not configured.",
+			},
+			{
+				Name:        "ip_restrictions",
+				Hydrate:     getKubeClusterIPRestrictions,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+				Description: "IP blocks allowed to call the API server, empty when there is no restriction.",
+			},
+			titleColumn(getKubeClusterInfo, "Name"),
+		},
+	}
+}
+
+type KubeCluster struct {
+	ProjectID                   string                           `json:"-"`
+	ID                          string                           `json:"id"`
+	Name                        string                           `json:"name"`
+	Region                      string                           `json:"region"`
+	Status                      string                           `json:"status"`
+	Version                     string                           `json:"version"`
+	NextUpgradeVersions         []string                         `json:"nextUpgradeVersions"`
+	IsUpToDate                  bool                             `json:"isUpToDate"`
+	ControlPlaneIsUpToDate      bool                             `json:"controlPlaneIsUpToDate"`
+	UpdatePolicy                string                           `json:"updatePolicy"`
+	URL                         string                           `json:"url"`
+	NodesURL                    string                           `json:"nodesUrl"`
+	KubeProxyMode               string                           `json:"kubeProxyMode"`
+	Customization               map[string]interface{}           `json:"customization"`
+	PrivateNetworkID            string                           `json:"privateNetworkId"`
+	PrivateNetworkConfiguration *KubePrivateNetworkConfiguration `json:"privateNetworkConfiguration"`
+	NodesSubnetID               string                           `json:"nodesSubnetId"`
+	LoadBalancersSubnetID       string                           `json:"loadBalancersSubnetId"`
+	AuditLogsSubscribed         bool                             `json:"auditLogsSubscribed"`
+	CreatedAt                   *time.Time                       `json:"createdAt"`
+	UpdatedAt                   *time.Time                       `json:"updatedAt"`
+}
+
+type KubePrivateNetworkConfiguration struct {
+	DefaultVrackGateway            string `json:"defaultVrackGateway"`
+	PrivateNetworkRoutingAsDefault bool   `json:"privateNetworkRoutingAsDefault"`
+}
+
+type KubeOpenIDConnect struct {
+	ClientID          string   `json:"clientId"`
+	IssuerURL         string   `json:"issuerUrl"`
+	CaContent         string   `json:"caContent,omitempty"`
+	GroupsClaim       []string `json:"groupsClaim,omitempty"`
+	GroupsPrefix      string   `json:"groupsPrefix,omitempty"`
+	RequiredClaim     []string `json:"requiredClaim,omitempty"`
+	SigningAlgorithms []string `json:"signingAlgorithms,omitempty"`
+	UsernameClaim     string   `json:"usernameClaim,omitempty"`
+	UsernamePrefix    string   `json:"usernamePrefix,omitempty"`
+}
+
+func getKubeClusterInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(KubeCluster)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.getKubeClusterInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s", cluster.ProjectID, cluster.ID), &cluster)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.getKubeClusterInfo", err)
+		return nil, err
+	}
+	return cluster, nil
+}
+
+func getKubeClusterOpenIDConnect(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(KubeCluster)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.getKubeClusterOpenIDConnect", "connection_error", err)
+		return nil, err
+	}
+
+	// The API answers 404 when OIDC is not configured, ignored by the
+	// hydrate config
+	var oidc KubeOpenIDConnect
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/openIdConnect", cluster.ProjectID, cluster.ID), &oidc)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.getKubeClusterOpenIDConnect", err)
+		return nil, err
+	}
+	return oidc, nil
+}
+
+func getKubeClusterIPRestrictions(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	cluster := h.Item.(KubeCluster)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.getKubeClusterIPRestrictions", "connection_error", err)
+		return nil, err
+	}
+
+	ips := []string{}
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/ipRestrictions", cluster.ProjectID, cluster.ID), &ips)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.getKubeClusterIPRestrictions", err)
+		return nil, err
+	}
+	return ips, nil
+}
+
+func listKubeCluster(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.listKubeCluster", "connection_error", err)
+		return nil, err
+	}
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		kubeIds, err := listKubeIds(client, projectId)
+		if err != nil {
+			return err
+		}
+		for _, kubeId := range kubeIds {
+			d.StreamListItem(ctx, KubeCluster{ProjectID: projectId, ID: kubeId})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_cluster.listKubeCluster", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// listKubeIds returns the IDs of the Kubernetes clusters of a cloud project.
+func listKubeIds(client *ovh.Client, projectId string) ([]string, error) {
+	var kubeIds []string
+	err := client.Get(fmt.Sprintf("/cloud/project/%s/kube", projectId), &kubeIds)
+	return kubeIds, err
+}
+
+// forEachKubeCluster calls listFunc for each Kubernetes cluster of the cloud
+// projects of forEachProject, or only for the cluster given in the kube_id
+// qual, which is fetched directly: the projects without it are skipped.
+func forEachKubeCluster(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(client *ovh.Client, projectId, kubeId string) error) error {
+	return forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		if kubeId := d.EqualsQuals["kube_id"].GetStringValue(); kubeId != "" {
+			var cluster KubeCluster
+			err := client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s", projectId, kubeId), &cluster)
+			if err != nil {
+				return err
+			}
+			return listFunc(client, projectId, kubeId)
+		}
+		kubeIds, err := listKubeIds(client, projectId)
+		if err != nil {
+			return err
+		}
+		for _, kubeId := range kubeIds {
+			if err := listFunc(client, projectId, kubeId); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func getKubeCluster(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = KubeCluster{
+		ProjectID: d.EqualsQuals["project_id"].GetStringValue(),
+		ID:        d.EqualsQuals["id"].GetStringValue(),
+	}
+	return getKubeClusterInfo(ctx, d, h)
+}
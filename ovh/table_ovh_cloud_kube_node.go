@@ -0,0 +1,163 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudKubeNode() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube_node",
+		Description: "A node of a Managed Kubernetes Service (MKS) cluster.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "kube_id"}),
+			Hydrate:    listKubeNode,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "kube_id", "id"}),
+			Hydrate:      getKubeNode,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "kube_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KubeID"),
+				Description: "Cluster ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node name.",
+			},
+			{
+				Name:        "node_pool_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NodePoolID"),
+				Description: "ID of the node pool of the node.",
+			},
+			{
+				Name:        "instance_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("InstanceID"),
+				Description: "ID of the instance of the node (see ovh_cloud_instance).",
+			},
+			{
+				Name:        "flavor",
+				Type:        proto.ColumnType_STRING,
+				Description: "Flavor of the node (e.g. b2-7).",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node status (READY, INSTALLING, UPDATING, ERROR...).",
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Kubernetes version of the node.",
+			},
+			{
+				Name:        "is_up_to_date",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsUpToDate"),
+				Description: "True if the node has the last version of Kubernetes.",
+			},
+			{
+				Name:        "deployed_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Node deployment date.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Node creation date.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the node.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type KubeNode struct {
+	ProjectID  string     `json:"-"`
+	KubeID     string     `json:"-"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	NodePoolID string     `json:"nodePoolId"`
+	InstanceID string     `json:"instanceId"`
+	Flavor     string     `json:"flavor"`
+	Status     string     `json:"status"`
+	Version    string     `json:"version"`
+	IsUpToDate bool       `json:"isUpToDate"`
+	DeployedAt *time.Time `json:"deployedAt"`
+	CreatedAt  *time.Time `json:"createdAt"`
+	UpdatedAt  *time.Time `json:"updatedAt"`
+}
+
+func listKubeNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.listKubeNode", "connection_error", err)
+		return nil, err
+	}
+	err = forEachKubeCluster(ctx, d, client, func(client *ovh.Client, projectId, kubeId string) error {
+		var nodes []KubeNode
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/node", projectId, kubeId), &nodes)
+		if err != nil {
+			return err
+		}
+		for _, node := range nodes {
+			node.ProjectID = projectId
+			node.KubeID = kubeId
+			d.StreamListItem(ctx, node)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.listKubeNode", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getKubeNode(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.getKubeNode", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	kubeId := d.EqualsQuals["kube_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var node KubeNode
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/node/%s", projectId, kubeId, id), &node)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node.getKubeNode", err)
+		return nil, err
+	}
+	node.ProjectID = projectId
+	node.KubeID = kubeId
+	return node, nil
+}
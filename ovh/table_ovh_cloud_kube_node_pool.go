@@ -0,0 +1,211 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudKubeNodePool() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_kube_node_pool",
+		Description: "A node pool of a Managed Kubernetes Service (MKS) cluster.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "kube_id"}),
+			Hydrate:    listKubeNodePool,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "kube_id", "id"}),
+			Hydrate:      getKubeNodePool,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "kube_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("KubeID"),
+				Description: "Cluster ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node pool ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node pool name.",
+			},
+			{
+				Name:        "flavor",
+				Type:        proto.ColumnType_STRING,
+				Description: "Flavor of the nodes (e.g. b2-7).",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Node pool status (READY, INSTALLING, UPDATING, ERROR...).",
+			},
+			{
+				Name:        "size_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the size of the node pool (CAPACITY_OK, OVER_CAPACITY or UNDER_CAPACITY).",
+			},
+			{
+				Name:        "autoscale",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Autoscale"),
+				Description: "True if the number of nodes is managed by the autoscaler.",
+			},
+			{
+				Name:        "autoscaling",
+				Type:        proto.ColumnType_JSON,
+				Description: "Parameters of the autoscaler (scale down thresholds).",
+			},
+			{
+				Name:        "desired_nodes",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of nodes wanted.",
+			},
+			{
+				Name:        "min_nodes",
+				Type:        proto.ColumnType_INT,
+				Description: "Minimum number of nodes of the autoscaler.",
+			},
+			{
+				Name:        "max_nodes",
+				Type:        proto.ColumnType_INT,
+				Description: "Maximum number of nodes of the autoscaler.",
+			},
+			{
+				Name:        "current_nodes",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of nodes.",
+			},
+			{
+				Name:        "available_nodes",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of nodes ready.",
+			},
+			{
+				Name:        "up_to_date_nodes",
+				Type:        proto.ColumnType_INT,
+				Description: "Number of nodes with the last version of Kubernetes.",
+			},
+			{
+				Name:        "monthly_billed",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("MonthlyBilled"),
+				Description: "True if the nodes are billed monthly.",
+			},
+			{
+				Name:        "anti_affinity",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("AntiAffinity"),
+				Description: "True if the nodes are spread on different hypervisors.",
+			},
+			{
+				Name:        "availability_zones",
+				Type:        proto.ColumnType_JSON,
+				Description: "Availability zones of the nodes.",
+			},
+			{
+				Name:        "template",
+				Type:        proto.ColumnType_JSON,
+				Description: "Metadata (labels, annotations) and spec (taints) applied to the nodes.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Node pool creation date.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the node pool.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type KubeNodePool struct {
+	ProjectID         string                 `json:"-"`
+	KubeID            string                 `json:"-"`
+	ID                string                 `json:"id"`
+	Name              string                 `json:"name"`
+	Flavor            string                 `json:"flavor"`
+	Status            string                 `json:"status"`
+	SizeStatus        string                 `json:"sizeStatus"`
+	Autoscale         bool                   `json:"autoscale"`
+	Autoscaling       map[string]interface{} `json:"autoscaling"`
+	DesiredNodes      int                    `json:"desiredNodes"`
+	MinNodes          int                    `json:"minNodes"`
+	MaxNodes          int                    `json:"maxNodes"`
+	CurrentNodes      int                    `json:"currentNodes"`
+	AvailableNodes    int                    `json:"availableNodes"`
+	UpToDateNodes     int                    `json:"upToDateNodes"`
+	MonthlyBilled     bool                   `json:"monthlyBilled"`
+	AntiAffinity      bool                   `json:"antiAffinity"`
+	AvailabilityZones []string               `json:"availabilityZones"`
+	Template          map[string]interface{} `json:"template"`
+	CreatedAt         *time.Time             `json:"createdAt"`
+	UpdatedAt         *time.Time             `json:"updatedAt"`
+}
+
+func listKubeNodePool(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node_pool.listKubeNodePool", "connection_error", err)
+		return nil, err
+	}
+	err = forEachKubeCluster(ctx, d, client, func(client *ovh.Client, projectId, kubeId string) error {
+		var nodePools []KubeNodePool
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool", projectId, kubeId), &nodePools)
+		if err != nil {
+			return err
+		}
+		for _, nodePool := range nodePools {
+			nodePool.ProjectID = projectId
+			nodePool.KubeID = kubeId
+			d.StreamListItem(ctx, nodePool)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node_pool.listKubeNodePool", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getKubeNodePool(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node_pool.getKubeNodePool", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	kubeId := d.EqualsQuals["kube_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var nodePool KubeNodePool
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/kube/%s/nodepool/%s", projectId, kubeId, id), &nodePool)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_kube_node_pool.getKubeNodePool", err)
+		return nil, err
+	}
+	nodePool.ProjectID = projectId
+	nodePool.KubeID = kubeId
+	return nodePool, nil
+}
@@ -0,0 +1,178 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudLoadBalancer() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_loadbalancer",
+		Description: "A Public Cloud Load Balancer (Octavia) of a cloud project.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "region"}),
+			Hydrate:    listLoadBalancer,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "region", "id"}),
+			Hydrate:      getLoadBalancer,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the load balancer.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Load balancer ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Load balancer name.",
+			},
+			{
+				Name:        "flavor_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Flavor ID of the load balancer (size).",
+			},
+			{
+				Name:        "operating_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Operating status (online, offline, degraded, error, noMonitor).",
+			},
+			{
+				Name:        "provisioning_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Provisioning status (active, creating, deleting, error...).",
+			},
+			{
+				Name:        "vip_address",
+				Type:        proto.ColumnType_INET,
+				Description: "Virtual IP of the load balancer in its network.",
+			},
+			{
+				Name:        "vip_network_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VipNetworkID"),
+				Description: "OpenStack ID of the network of the virtual IP.",
+			},
+			{
+				Name:        "vip_subnet_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("VipSubnetID"),
+				Description: "ID of the subnet of the virtual IP.",
+			},
+			{
+				Name:        "floating_ip",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("FloatingIP"),
+				Description: "Floating IP exposing the load balancer, null when the load balancer is private.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Load balancer creation date.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the load balancer.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type LoadBalancer struct {
+	ProjectID          string                  `json:"-"`
+	Region             string                  `json:"region"`
+	ID                 string                  `json:"id"`
+	Name               string                  `json:"name"`
+	FlavorID           string                  `json:"flavorId"`
+	OperatingStatus    string                  `json:"operatingStatus"`
+	ProvisioningStatus string                  `json:"provisioningStatus"`
+	VipAddress         string                  `json:"vipAddress"`
+	VipNetworkID       string                  `json:"vipNetworkId"`
+	VipSubnetID        string                  `json:"vipSubnetId"`
+	FloatingIP         *LoadBalancerFloatingIP `json:"floatingIp"`
+	CreatedAt          *time.Time              `json:"createdAt"`
+	UpdatedAt          *time.Time              `json:"updatedAt"`
+}
+
+type LoadBalancerFloatingIP struct {
+	ID string `json:"id"`
+	IP string `json:"ip"`
+}
+
+func listLoadBalancer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer.listLoadBalancer", "connection_error", err)
+		return nil, err
+	}
+	err = forEachRegion(ctx, d, client, func(client *ovh.Client, projectId, regionName string) error {
+		var loadBalancers []LoadBalancer
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing/loadbalancer", projectId, regionName), &loadBalancers)
+		if err != nil {
+			return err
+		}
+		for _, loadBalancer := range loadBalancers {
+			loadBalancer.ProjectID = projectId
+			loadBalancer.Region = regionName
+			d.StreamListItem(ctx, loadBalancer)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer.listLoadBalancer", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getLoadBalancer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer.getLoadBalancer", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	regionName := d.EqualsQuals["region"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var loadBalancer LoadBalancer
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing/loadbalancer/%s", projectId, regionName, id), &loadBalancer)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer.getLoadBalancer", err)
+		return nil, err
+	}
+	loadBalancer.ProjectID = projectId
+	loadBalancer.Region = regionName
+	return loadBalancer, nil
+}
+
+// loadBalancerQuery returns the query string filtering the listeners or the
+// pools on the load balancer given in the loadbalancer_id qual.
+func loadBalancerQuery(d *plugin.QueryData) string {
+	loadBalancerId := d.EqualsQuals["loadbalancer_id"].GetStringValue()
+	if loadBalancerId == "" {
+		return ""
+	}
+	return "?" + url.Values{"loadbalancerId": {loadBalancerId}}.Encode()
+}
@@ -0,0 +1,167 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudLoadBalancerListener() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_loadbalancer_listener",
+		Description: "A listener (port and protocol) of a Public Cloud Load Balancer.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "region", "loadbalancer_id"}),
+			Hydrate:    listLoadBalancerListener,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "region", "id"}),
+			Hydrate:      getLoadBalancerListener,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the load balancer.",
+			},
+			{
+				Name:        "loadbalancer_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerID"),
+				Description: "ID of the load balancer of the listener.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Listener ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Listener name.",
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Listener description.",
+			},
+			{
+				Name:        "protocol",
+				Type:        proto.ColumnType_STRING,
+				Description: "Protocol of the listener (http, https, tcp, terminated-https, udp, prometheus, sctp).",
+			},
+			{
+				Name:        "port",
+				Type:        proto.ColumnType_INT,
+				Description: "Port of the listener.",
+			},
+			{
+				Name:        "default_pool_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "ID of the pool receiving the traffic.",
+			},
+			{
+				Name:        "operating_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Operating status (online, offline, degraded, error, noMonitor).",
+			},
+			{
+				Name:        "provisioning_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Provisioning status (active, creating, deleting, error...).",
+			},
+			{
+				Name:        "allowed_cidrs",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("AllowedCIDRs"),
+				Description: "IP blocks allowed to access the listener, empty when all are allowed.",
+			},
+			{
+				Name:        "secret_id",
+				Type:        proto.ColumnType_STRING,
+				Description: "ID of the secret of the TLS certificate of a terminated-https listener.",
+			},
+			{
+				Name:        "tls_versions",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("TLSVersions"),
+				Description: "TLS versions accepted by a terminated-https listener.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type LoadBalancerListener struct {
+	ProjectID          string   `json:"-"`
+	Region             string   `json:"-"`
+	LoadBalancerID     string   `json:"loadbalancerId"`
+	ID                 string   `json:"id"`
+	Name               string   `json:"name"`
+	Description        string   `json:"description"`
+	Protocol           string   `json:"protocol"`
+	Port               int      `json:"port"`
+	DefaultPoolID      string   `json:"defaultPoolId"`
+	OperatingStatus    string   `json:"operatingStatus"`
+	ProvisioningStatus string   `json:"provisioningStatus"`
+	AllowedCIDRs       []string `json:"allowedCidrs"`
+	SecretID           string   `json:"secretId"`
+	TLSVersions        []string `json:"tlsVersions"`
+}
+
+func listLoadBalancerListener(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_listener.listLoadBalancerListener", "connection_error", err)
+		return nil, err
+	}
+	err = forEachRegion(ctx, d, client, func(client *ovh.Client, projectId, regionName string) error {
+		var listeners []LoadBalancerListener
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing/listener", projectId, regionName)+loadBalancerQuery(d), &listeners)
+		if err != nil {
+			return err
+		}
+		for _, listener := range listeners {
+			listener.ProjectID = projectId
+			listener.Region = regionName
+			d.StreamListItem(ctx, listener)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_listener.listLoadBalancerListener", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getLoadBalancerListener(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_listener.getLoadBalancerListener", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	regionName := d.EqualsQuals["region"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var listener LoadBalancerListener
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing/listener/%s", projectId, regionName, id), &listener)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_listener.getLoadBalancerListener", err)
+		return nil, err
+	}
+	listener.ProjectID = projectId
+	listener.Region = regionName
+	return listener, nil
+}
@@ -0,0 +1,128 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudLoadBalancerMember() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_loadbalancer_member",
+		Description: "A member (backend server) of a pool of a Public Cloud Load Balancer.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "region", "pool_id"}),
+			Hydrate:    listLoadBalancerMember,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the load balancer.",
+			},
+			{
+				Name:        "pool_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("PoolID"),
+				Description: "ID of the pool of the member.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Member ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Member name.",
+			},
+			{
+				Name:        "address",
+				Type:        proto.ColumnType_INET,
+				Description: "IP of the member.",
+			},
+			{
+				Name:        "protocol_port",
+				Type:        proto.ColumnType_INT,
+				Description: "Port of the member receiving the traffic.",
+			},
+			{
+				Name:        "weight",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Weight"),
+				Description: "Weight of the member in the pool, 0 when the member receives no new connection.",
+			},
+			{
+				Name:        "operating_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Operating status (online, offline, degraded, error, noMonitor).",
+			},
+			{
+				Name:        "provisioning_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Provisioning status (active, creating, deleting, error...).",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type LoadBalancerMember struct {
+	ProjectID          string `json:"-"`
+	Region             string `json:"-"`
+	PoolID             string `json:"-"`
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	Address            string `json:"address"`
+	ProtocolPort       int    `json:"protocolPort"`
+	Weight             int    `json:"weight"`
+	OperatingStatus    string `json:"operatingStatus"`
+	ProvisioningStatus string `json:"provisioningStatus"`
+}
+
+func listLoadBalancerMember(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_member.listLoadBalancerMember", "connection_error", err)
+		return nil, err
+	}
+	err = forEachRegion(ctx, d, client, func(client *ovh.Client, projectId, regionName string) error {
+		pools, err := listLoadBalancerPools(client, projectId, regionName, "")
+		if err != nil {
+			return err
+		}
+		qualPoolId := d.EqualsQuals["pool_id"].GetStringValue()
+		for _, pool := range pools {
+			if qualPoolId != "" && pool.ID != qualPoolId {
+				continue
+			}
+			var members []LoadBalancerMember
+			err := client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing/pool/%s/member", projectId, regionName, pool.ID), &members)
+			if err != nil {
+				return err
+			}
+			for _, member := range members {
+				member.ProjectID = projectId
+				member.Region = regionName
+				member.PoolID = pool.ID
+				d.StreamListItem(ctx, member)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_member.listLoadBalancerMember", err)
+		return nil, err
+	}
+	return nil, nil
+}
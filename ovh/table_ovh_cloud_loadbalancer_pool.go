@@ -0,0 +1,148 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudLoadBalancerPool() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_loadbalancer_pool",
+		Description: "A pool of members of a Public Cloud Load Balancer.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "region", "loadbalancer_id"}),
+			Hydrate:    listLoadBalancerPool,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "region", "id"}),
+			Hydrate:      getLoadBalancerPool,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the load balancer.",
+			},
+			{
+				Name:        "loadbalancer_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("LoadBalancerID"),
+				Description: "ID of the load balancer of the pool.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Pool ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Pool name.",
+			},
+			{
+				Name:        "protocol",
+				Type:        proto.ColumnType_STRING,
+				Description: "Protocol of the members (http, https, tcp, udp, proxy, proxyV2, sctp).",
+			},
+			{
+				Name:        "algorithm",
+				Type:        proto.ColumnType_STRING,
+				Description: "Load balancing algorithm (roundRobin, leastConnections, sourceIP).",
+			},
+			{
+				Name:        "operating_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Operating status (online, offline, degraded, error, noMonitor).",
+			},
+			{
+				Name:        "provisioning_status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Provisioning status (active, creating, deleting, error...).",
+			},
+			{
+				Name:        "session_persistence",
+				Type:        proto.ColumnType_JSON,
+				Description: "Session persistence of the pool, null when disabled.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type LoadBalancerPool struct {
+	ProjectID          string                 `json:"-"`
+	Region             string                 `json:"-"`
+	LoadBalancerID     string                 `json:"loadbalancerId"`
+	ID                 string                 `json:"id"`
+	Name               string                 `json:"name"`
+	Protocol           string                 `json:"protocol"`
+	Algorithm          string                 `json:"algorithm"`
+	OperatingStatus    string                 `json:"operatingStatus"`
+	ProvisioningStatus string                 `json:"provisioningStatus"`
+	SessionPersistence map[string]interface{} `json:"sessionPersistence"`
+}
+
+func listLoadBalancerPool(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_pool.listLoadBalancerPool", "connection_error", err)
+		return nil, err
+	}
+	err = forEachRegion(ctx, d, client, func(client *ovh.Client, projectId, regionName string) error {
+		pools, err := listLoadBalancerPools(client, projectId, regionName, loadBalancerQuery(d))
+		if err != nil {
+			return err
+		}
+		for _, pool := range pools {
+			pool.ProjectID = projectId
+			pool.Region = regionName
+			d.StreamListItem(ctx, pool)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_pool.listLoadBalancerPool", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// listLoadBalancerPools returns the load balancer pools of a region of a cloud
+// project, filtered by the query.
+func listLoadBalancerPools(client *ovh.Client, projectId, regionName, query string) ([]LoadBalancerPool, error) {
+	var pools []LoadBalancerPool
+	err := client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing/pool", projectId, regionName)+query, &pools)
+	return pools, err
+}
+
+func getLoadBalancerPool(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_pool.getLoadBalancerPool", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	regionName := d.EqualsQuals["region"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var pool LoadBalancerPool
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/loadbalancing/pool/%s", projectId, regionName, id), &pool)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_loadbalancer_pool.getLoadBalancerPool", err)
+		return nil, err
+	}
+	pool.ProjectID = projectId
+	pool.Region = regionName
+	return pool, nil
+}
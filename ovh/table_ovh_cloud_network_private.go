@@ -0,0 +1,154 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudNetworkPrivate() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_network_private",
+		Description: "A private network (vRack VLAN) of a cloud project.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:    listNetworkPrivate,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getNetworkPrivate,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network name.",
+			},
+			{
+				Name:        "vlan_id",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("VlanID"),
+				Description: "ID of the VLAN of the network in the vRack.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network status (ACTIVE, BUILDING, DELETING...).",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network type.",
+			},
+			{
+				Name:        "regions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Regions where the network is available, with their status and OpenStack ID.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type Network struct {
+	ProjectID string          `json:"-"`
+	ID        string          `json:"id"`
+	Name      string          `json:"name"`
+	VlanID    int             `json:"vlanId"`
+	Status    string          `json:"status"`
+	Type      string          `json:"type"`
+	Regions   []NetworkRegion `json:"regions"`
+}
+
+type NetworkRegion struct {
+	Region      string `json:"region"`
+	Status      string `json:"status"`
+	OpenstackID string `json:"openstackId"`
+}
+
+func listNetworkPrivate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.listNetworkPrivate", "connection_error", err)
+		return nil, err
+	}
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		networks, err := listPrivateNetworks(client, projectId)
+		if err != nil {
+			return err
+		}
+		for _, network := range networks {
+			network.ProjectID = projectId
+			d.StreamListItem(ctx, network)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.listNetworkPrivate", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// listPrivateNetworks returns the private networks of a cloud project.
+func listPrivateNetworks(client *ovh.Client, projectId string) ([]Network, error) {
+	var networks []Network
+	err := client.Get(fmt.Sprintf("/cloud/project/%s/network/private", projectId), &networks)
+	return networks, err
+}
+
+// forEachPrivateNetwork calls listFunc for each private network of the cloud
+// projects of forEachProject, or only for the network given in the network_id
+// qual.
+func forEachPrivateNetwork(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(client *ovh.Client, projectId, networkId string) error) error {
+	return forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		networks, err := listPrivateNetworks(client, projectId)
+		if err != nil {
+			return err
+		}
+		qualNetworkId := d.EqualsQuals["network_id"].GetStringValue()
+		for _, network := range networks {
+			if qualNetworkId != "" && network.ID != qualNetworkId {
+				continue
+			}
+			if err := listFunc(client, projectId, network.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func getNetworkPrivate(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.getNetworkPrivate", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var network Network
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/network/private/%s", projectId, id), &network)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_private.getNetworkPrivate", err)
+		return nil, err
+	}
+	network.ProjectID = projectId
+	return network, nil
+}
@@ -0,0 +1,81 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudNetworkPublic() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_network_public",
+		Description: "The public network (Ext-Net) of a cloud project.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:    listNetworkPublic,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network name.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network status.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Network type.",
+			},
+			{
+				Name:        "regions",
+				Type:        proto.ColumnType_JSON,
+				Description: "Regions where the network is available, with their status and OpenStack ID.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+func listNetworkPublic(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_public.listNetworkPublic", "connection_error", err)
+		return nil, err
+	}
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var networks []Network
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/network/public", projectId), &networks)
+		if err != nil {
+			return err
+		}
+		for _, network := range networks {
+			network.ProjectID = projectId
+			d.StreamListItem(ctx, network)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_public.listNetworkPublic", err)
+		return nil, err
+	}
+	return nil, nil
+}
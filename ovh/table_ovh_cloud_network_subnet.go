@@ -0,0 +1,102 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudNetworkSubnet() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_network_subnet",
+		Description: "A subnet of a private network of a cloud project.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "network_id"}),
+			Hydrate:    listNetworkSubnet,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "network_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("NetworkID"),
+				Description: "ID of the private network of the subnet.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Subnet ID.",
+			},
+			{
+				Name:        "cidr",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("CIDR"),
+				Description: "IP block of the subnet.",
+			},
+			{
+				Name:        "gateway_ip",
+				Type:        proto.ColumnType_INET,
+				Description: "IP of the gateway of the subnet, null when the subnet has no gateway.",
+			},
+			{
+				Name:        "ip_pools",
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("IPPools"),
+				Description: "Allocation pools of the subnet by region, with their DHCP setting.",
+			},
+			titleColumn(nil, "CIDR"),
+		},
+	}
+}
+
+type Subnet struct {
+	ProjectID string         `json:"-"`
+	NetworkID string         `json:"-"`
+	ID        string         `json:"id"`
+	CIDR      string         `json:"cidr"`
+	GatewayIP string         `json:"gatewayIp"`
+	IPPools   []SubnetIPPool `json:"ipPools"`
+}
+
+type SubnetIPPool struct {
+	Region  string `json:"region"`
+	Network string `json:"network"`
+	Start   string `json:"start"`
+	End     string `json:"end"`
+	DHCP    bool   `json:"dhcp"`
+}
+
+func listNetworkSubnet(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_subnet.listNetworkSubnet", "connection_error", err)
+		return nil, err
+	}
+	err = forEachPrivateNetwork(ctx, d, client, func(client *ovh.Client, projectId, networkId string) error {
+		var subnets []Subnet
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/network/private/%s/subnet", projectId, networkId), &subnets)
+		if err != nil {
+			return err
+		}
+		for _, subnet := range subnets {
+			subnet.ProjectID = projectId
+			subnet.NetworkID = networkId
+			d.StreamListItem(ctx, subnet)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_network_subnet.listNetworkSubnet", err)
+		return nil, err
+	}
+	return nil, nil
+}
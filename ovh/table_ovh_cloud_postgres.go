@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -14,18 +15,26 @@ func tableOvhCloudPostgres() *plugin.Table {
 		Name:        "ovh_cloud_postgres",
 		Description: "An hosted PostgreSQL database.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listPostgres,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getPostgres,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getPostgres,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getPostgresInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -87,13 +96,17 @@ func tableOvhCloudPostgres() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "The VM flavor used for this cluster.",
 			},
+			titleColumn(getPostgresInfo, "Description"),
 		},
 	}
 
 }
 func getPostgresInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	postgres := h.Item.(Database)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	// The get call already fetched the details
+	if postgres.CreatedAt != nil {
+		return postgres, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -101,7 +114,7 @@ func getPostgresInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/postgresql/%s", projectId, postgres.ID), &postgres)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/postgresql/%s", postgres.ProjectID, postgres.ID), &postgres)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_postgres.getPostgresInfo", err)
 		return nil, err
@@ -110,29 +123,37 @@ func getPostgresInfo(ctx context.Context, d *plugin.QueryData, h *plugin.Hydrate
 }
 
 func listPostgres(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_postgres.listPostgres", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var postgresIds []string
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/database/postgresql", projectId), &postgresIds)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var postgresIds []string
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/database/postgresql", projectId), &postgresIds)
+		if err != nil {
+			return err
+		}
+		for _, postgresId := range postgresIds {
+			var postgres Database
+			postgres.ProjectID = projectId
+			postgres.ID = postgresId
+			d.StreamListItem(ctx, postgres)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_postgres.listPostgres", err)
 		return nil, err
 	}
-	for _, postgresId := range postgresIds {
-		var postgres Database
-		postgres.ID = postgresId
-		d.StreamListItem(ctx, postgres)
-	}
 	return nil, nil
 }
 
-func getPostgres(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getPostgres(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	id := d.EqualsQuals["id"].GetStringValue()
 	var postgres Database
+	postgres.ProjectID = d.EqualsQuals["project_id"].GetStringValue()
 	postgres.ID = id
-	return postgres, nil
+	h.Item = postgres
+	return getPostgresInfo(ctx, d, h)
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,14 +16,20 @@ func tableOvhCloudProject() *plugin.Table {
 		Name:        "ovh_cloud_project",
 		Description: "A cloud project is a way to regroup instance, storage, database, ... under a name.",
 		List: &plugin.ListConfig{
-			Hydrate: listProject,
+			Hydrate:    listProject,
+			KeyColumns: iamTagsKeyColumns(),
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("id"),
-			Hydrate:    getProject,
+			KeyColumns:   plugin.SingleColumn("id"),
+			Hydrate:      getProject,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getProjectInfo},
+			{
+				Func:           getProjectInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -93,6 +100,9 @@ func tableOvhCloudProject() *plugin.Table {
 				Transform:   transform.FromField("IAM"),
 				Description: "IAM resource metadata.",
 			},
+			titleColumn(getProjectInfo, "Name"),
+			akasColumn(getProjectInfo, "IAM.URN"),
+			tagsColumn(getProjectInfo, "IAM.Tags"),
 		},
 	}
 }
@@ -121,6 +131,10 @@ type Project struct {
 
 func getProjectInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	project := h.Item.(Project)
+	// The get call already fetched the details
+	if !project.CreationDate.IsZero() {
+		return project, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -137,13 +151,13 @@ func getProjectInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateD
 }
 
 func listProject(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_project.listProject", "connection_error", err)
 		return nil, err
 	}
 	var projects []string
-	err = client.Get("/cloud/project", &projects)
+	err = client.Get("/cloud/project"+iamTagsQuery(d, "iamTags"), &projects)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_project.listProject", err)
 		return nil, err
@@ -156,10 +170,18 @@ func listProject(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData
 	return nil, nil
 }
 
+// listProjectIds returns the IDs of all the cloud projects of the account.
+func listProjectIds(client *ovh.Client) ([]string, error) {
+	var projects []string
+	err := client.Get("/cloud/project", &projects)
+	return projects, err
+}
+
 func getProject(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	quals := d.EqualsQuals
 	projectId := quals["id"].GetStringValue()
 	var project Project
 	project.ID = projectId
-	return project, nil
+	h.Item = project
+	return getProjectInfo(ctx, d, h)
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -14,18 +15,26 @@ func tableOvhCloudRegion() *plugin.Table {
 		Name:        "ovh_cloud_region",
 		Description: "Regions available for a cloud project.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listRegion,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "name"}),
-			Hydrate:    getRegion,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "name"}),
+			Hydrate:      getRegion,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getRegionInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -69,12 +78,14 @@ func tableOvhCloudRegion() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Region type.",
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 
 }
 
 type Region struct {
+	ProjectID          string      `json:"-"`
 	Name               string      `json:"name"`
 	ContinentCode      string      `json:"continentCode"`
 	DatacenterLocation string      `json:"datacenterLocation"`
@@ -92,7 +103,6 @@ type Component struct {
 
 func getRegionInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	region := h.Item.(Region)
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -100,7 +110,7 @@ func getRegionInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s", projectId, region.Name), &region)
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s", region.ProjectID, region.Name), &region)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_region.getRegionInfo", err)
 		return nil, err
@@ -109,29 +119,65 @@ func getRegionInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 }
 
 func listRegion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_region.listRegion", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var regionNames []string
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/region", projectId), &regionNames)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		regionNames, err := listRegionNames(client, projectId)
+		if err != nil {
+			return err
+		}
+		for _, regionName := range regionNames {
+			var region Region
+			region.ProjectID = projectId
+			region.Name = regionName
+			d.StreamListItem(ctx, region)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_region.listRegion", err)
 		return nil, err
 	}
-	for _, regionName := range regionNames {
-		var region Region
-		region.Name = regionName
-		d.StreamListItem(ctx, region)
-	}
 	return nil, nil
 }
 
-func getRegion(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+// listRegionNames returns the names of the regions of a cloud project.
+func listRegionNames(client *ovh.Client, projectId string) ([]string, error) {
+	var regionNames []string
+	err := client.Get(fmt.Sprintf("/cloud/project/%s/region", projectId), &regionNames)
+	return regionNames, err
+}
+
+// forEachRegion calls listFunc for each region of the cloud projects of
+// forEachProject, or only for the region given in the region qual. The regions
+// where a regional endpoint does not exist (404) are skipped.
+func forEachRegion(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(client *ovh.Client, projectId, regionName string) error) error {
+	return forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		regionNames, err := listRegionNames(client, projectId)
+		if err != nil {
+			return err
+		}
+		qualRegion := d.EqualsQuals["region"].GetStringValue()
+		for _, regionName := range regionNames {
+			if qualRegion != "" && regionName != qualRegion {
+				continue
+			}
+			if err := listFunc(client, projectId, regionName); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func getRegion(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	name := d.EqualsQuals["name"].GetStringValue()
 	var region Region
+	region.ProjectID = d.EqualsQuals["project_id"].GetStringValue()
 	region.Name = name
-	return region, nil
+	h.Item = region
+	return getRegionInfo(ctx, d, h)
 }
@@ -0,0 +1,268 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudRegistry() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_registry",
+		Description: "A Managed Private Registry (Harbor) of a cloud project.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
+			Hydrate:    listRegistry,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getRegistry,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getRegistryPlan,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getRegistryOpenIDConnect,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Description: "Registry ID.",
+			},
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Registry name.",
+			},
+			{
+				Name:        "region",
+				Type:        proto.ColumnType_STRING,
+				Description: "Region of the registry.",
+			},
+			{
+				Name:        "status",
+				Type:        proto.ColumnType_STRING,
+				Description: "Registry status (READY, INSTALLING, ERROR...).",
+			},
+			{
+				Name:        "url",
+				Type:        proto.ColumnType_STRING,
+				Description: "URL of the registry.",
+			},
+			{
+				Name:        "version",
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of Harbor of the registry.",
+			},
+			{
+				Name:        "size",
+				Type:        proto.ColumnType_INT,
+				Description: "Size used by the images (in bytes).",
+			},
+			{
+				Name:        "size_limit",
+				Hydrate:     getRegistryPlan,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("RegistryLimits.ImageStorage"),
+				Description: "Maximum size of the images of the plan (in bytes).",
+			},
+			{
+				Name:        "plan",
+				Hydrate:     getRegistryPlan,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+				Description: "Plan of the registry, with its limits and features.",
+			},
+			{
+				Name:        "iam_enabled",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IAMEnabled"),
+				Description: "True if the users of the registry are managed by the IAM.",
+			},
+			{
+				Name:        "open_id_connect",
+				Hydrate:     getRegistryOpenIDConnect,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+				Description: "OIDC configuration of the registry, null when the authentication by OIDC is not configured.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Registry creation date.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the registry.",
+			},
+			titleColumn(nil, "Name"),
+		},
+	}
+}
+
+type Registry struct {
+	ProjectID  string     `json:"-"`
+	ID         string     `json:"id"`
+	Name       string     `json:"name"`
+	Region     string     `json:"region"`
+	Status     string     `json:"status"`
+	URL        string     `json:"url"`
+	Version    string     `json:"version"`
+	Size       int64      `json:"size"`
+	IAMEnabled bool       `json:"iamEnabled"`
+	CreatedAt  *time.Time `json:"createdAt"`
+	UpdatedAt  *time.Time `json:"updatedAt"`
+}
+
+type RegistryPlan struct {
+	ID             string                 `json:"id"`
+	Name           string                 `json:"name"`
+	Code           string                 `json:"code"`
+	RegistryLimits RegistryLimits         `json:"registryLimits"`
+	Features       map[string]interface{} `json:"features"`
+}
+
+type RegistryLimits struct {
+	ImageStorage    int64 `json:"imageStorage"`
+	ParallelRequest int64 `json:"parallelRequest"`
+}
+
+type RegistryOpenIDConnect struct {
+	Name        string `json:"name"`
+	Endpoint    string `json:"endpoint"`
+	ClientID    string `json:"clientId"`
+	Scope       string `json:"scope"`
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	AdminGroup  string `json:"adminGroup,omitempty"`
+	UserClaim   string `json:"userClaim,omitempty"`
+	VerifyCert  bool   `json:"verifyCert"`
+}
+
+func listRegistry(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.listRegistry", "connection_error", err)
+		return nil, err
+	}
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		registries, err := listRegistries(client, projectId)
+		if err != nil {
+			return err
+		}
+		for _, registry := range registries {
+			registry.ProjectID = projectId
+			d.StreamListItem(ctx, registry)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.listRegistry", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+// listRegistries returns the container registries of a cloud project.
+func listRegistries(client *ovh.Client, projectId string) ([]Registry, error) {
+	var registries []Registry
+	err := client.Get(fmt.Sprintf("/cloud/project/%s/containerRegistry", projectId), &registries)
+	return registries, err
+}
+
+// forEachRegistry calls listFunc for each container registry of the cloud
+// projects of forEachProject, or only for the registry given in the
+// registry_id qual.
+func forEachRegistry(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(client *ovh.Client, projectId, registryId string) error) error {
+	return forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		registries, err := listRegistries(client, projectId)
+		if err != nil {
+			return err
+		}
+		qualRegistryId := d.EqualsQuals["registry_id"].GetStringValue()
+		for _, registry := range registries {
+			if qualRegistryId != "" && registry.ID != qualRegistryId {
+				continue
+			}
+			if err := listFunc(client, projectId, registry.ID); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func getRegistry(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.getRegistry", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetStringValue()
+	var registry Registry
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/containerRegistry/%s", projectId, id), &registry)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.getRegistry", err)
+		return nil, err
+	}
+	registry.ProjectID = projectId
+	return registry, nil
+}
+
+func getRegistryPlan(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	registry := h.Item.(Registry)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.getRegistryPlan", "connection_error", err)
+		return nil, err
+	}
+
+	var plan RegistryPlan
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/containerRegistry/%s/plan", registry.ProjectID, registry.ID), &plan)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.getRegistryPlan", err)
+		return nil, err
+	}
+	return plan, nil
+}
+
+func getRegistryOpenIDConnect(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	registry := h.Item.(Registry)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.getRegistryOpenIDConnect", "connection_error", err)
+		return nil, err
+	}
+
+	// The API answers 404 when OIDC is not configured, ignored by the
+	// hydrate config
+	var oidc RegistryOpenIDConnect
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/containerRegistry/%s/openIdConnect", registry.ProjectID, registry.ID), &oidc)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry.getRegistryOpenIDConnect", err)
+		return nil, err
+	}
+	return oidc, nil
+}
@@ -0,0 +1,114 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+// Kinds of IP restrictions of a registry: on the management API and UI of
+// Harbor, and on the registry of the images.
+var registryIPRestrictionTypes = []string{"management", "registry"}
+
+func tableOvhCloudRegistryIPRestriction() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_registry_ip_restriction",
+		Description: "An IP block allowed to access a Managed Private Registry.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "registry_id", "type"}),
+			Hydrate:    listRegistryIPRestriction,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "registry_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryID"),
+				Description: "Registry ID.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Access restricted: management (the API and UI of Harbor) or registry (the images).",
+			},
+			{
+				Name:        "ip_block",
+				Type:        proto.ColumnType_CIDR,
+				Description: "IP block allowed.",
+			},
+			{
+				Name:        "description",
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the IP block.",
+			},
+			{
+				Name:        "created_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Creation date of the restriction.",
+			},
+			{
+				Name:        "updated_at",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the restriction.",
+			},
+			titleColumn(nil, "IPBlock"),
+		},
+	}
+}
+
+type RegistryIPRestriction struct {
+	ProjectID   string     `json:"-"`
+	RegistryID  string     `json:"-"`
+	Type        string     `json:"-"`
+	IPBlock     string     `json:"ipBlock"`
+	Description string     `json:"description"`
+	CreatedAt   *time.Time `json:"createdAt"`
+	UpdatedAt   *time.Time `json:"updatedAt"`
+}
+
+func listRegistryIPRestriction(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry_ip_restriction.listRegistryIPRestriction", "connection_error", err)
+		return nil, err
+	}
+	types := registryIPRestrictionTypes
+	if restrictionType := d.EqualsQuals["type"].GetStringValue(); restrictionType != "" {
+		if !slices.Contains(types, restrictionType) {
+			return nil, nil
+		}
+		types = []string{restrictionType}
+	}
+	err = forEachRegistry(ctx, d, client, func(client *ovh.Client, projectId, registryId string) error {
+		for _, restrictionType := range types {
+			var restrictions []RegistryIPRestriction
+			err := client.Get(fmt.Sprintf("/cloud/project/%s/containerRegistry/%s/ipRestrictions/%s", projectId, registryId, restrictionType), &restrictions)
+			if err != nil {
+				return err
+			}
+			for _, restriction := range restrictions {
+				restriction.ProjectID = projectId
+				restriction.RegistryID = registryId
+				restriction.Type = restrictionType
+				d.StreamListItem(ctx, restriction)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry_ip_restriction.listRegistryIPRestriction", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,111 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhCloudRegistryUser() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_cloud_registry_user",
+		Description: "A user of a Managed Private Registry.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"project_id", "registry_id"}),
+			Hydrate:    listRegistryUser,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "registry_id", "id"}),
+			Hydrate:      getRegistryUser,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "project_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ProjectID"),
+				Description: "Project ID.",
+			},
+			{
+				Name:        "registry_id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RegistryID"),
+				Description: "Registry ID.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "User ID.",
+			},
+			{
+				Name:        "user",
+				Type:        proto.ColumnType_STRING,
+				Description: "Login of the user.",
+			},
+			{
+				Name:        "email",
+				Type:        proto.ColumnType_STRING,
+				Description: "Email of the user.",
+			},
+			titleColumn(nil, "User"),
+		},
+	}
+}
+
+type RegistryUser struct {
+	ProjectID  string `json:"-"`
+	RegistryID string `json:"-"`
+	ID         int64  `json:"id"`
+	User       string `json:"user"`
+	Email      string `json:"email"`
+}
+
+func listRegistryUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry_user.listRegistryUser", "connection_error", err)
+		return nil, err
+	}
+	err = forEachRegistry(ctx, d, client, func(client *ovh.Client, projectId, registryId string) error {
+		var users []RegistryUser
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/containerRegistry/%s/users", projectId, registryId), &users)
+		if err != nil {
+			return err
+		}
+		for _, user := range users {
+			user.ProjectID = projectId
+			user.RegistryID = registryId
+			d.StreamListItem(ctx, user)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry_user.listRegistryUser", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getRegistryUser(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry_user.getRegistryUser", "connection_error", err)
+		return nil, err
+	}
+	projectId := d.EqualsQuals["project_id"].GetStringValue()
+	registryId := d.EqualsQuals["registry_id"].GetStringValue()
+	id := d.EqualsQuals["id"].GetInt64Value()
+	var user RegistryUser
+	err = client.Get(fmt.Sprintf("/cloud/project/%s/containerRegistry/%s/users/%d", projectId, registryId, id), &user)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_cloud_registry_user.getRegistryUser", err)
+		return nil, err
+	}
+	user.ProjectID = projectId
+	user.RegistryID = registryId
+	return user, nil
+}
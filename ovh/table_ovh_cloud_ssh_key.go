@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -14,18 +15,19 @@ func tableOvhCloudSshKey() *plugin.Table {
 		Name:        "ovh_cloud_ssh_key",
 		Description: "An ssh key allows you to connect to an instance.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listSshKey,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getSshKey,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getSshKey,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -43,32 +45,40 @@ func tableOvhCloudSshKey() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "SSH public key.",
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
 
 type SshKey struct {
+	ProjectID string `json:"-"`
 	ID        string `json:"id"`
 	Name      string `json:"name"`
 	PublicKey string `json:"publicKey"`
 }
 
 func listSshKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ssh_key.listSshKey", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var sshKeys []SshKey
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/sshkey", projectId), &sshKeys)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var sshKeys []SshKey
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/sshkey", projectId), &sshKeys)
+		if err != nil {
+			return err
+		}
+		for _, sshKey := range sshKeys {
+			sshKey.ProjectID = projectId
+			d.StreamListItem(ctx, sshKey)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_ssh_key.listSshKey", err)
 		return nil, err
 	}
-	for _, sshKey := range sshKeys {
-		d.StreamListItem(ctx, sshKey)
-	}
 	return nil, nil
 }
 
@@ -86,5 +96,6 @@ func getSshKey(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		plugin.Logger(ctx).Error("ovh_cloud_ssh_key.getSshKey", err)
 		return nil, err
 	}
+	sshKey.ProjectID = projectId
 	return sshKey, nil
 }
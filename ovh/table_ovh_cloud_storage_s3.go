@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,22 @@ func tableOvhCloudStorageS3() *plugin.Table {
 		Name:        "ovh_cloud_storage_s3",
 		Description: "A S3 storage is an object storage.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "region"}),
-			Hydrate:    listS3StorageContainer,
+			KeyColumns: []*plugin.KeyColumn{
+				{Name: "project_id", Require: plugin.Optional},
+				{Name: "region"},
+			},
+			Hydrate: listS3StorageContainer,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "region", "name"}),
-			Hydrate:    getS3StorageContainer,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "region", "name"}),
+			Hydrate:      getS3StorageContainer,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -70,11 +75,13 @@ func tableOvhCloudStorageS3() *plugin.Table {
 				Description: "Encryption configuration.",
 				Transform:   transform.FromField("Encryption.SSEAlgorithm"),
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
 
 type S3StorageContainer struct {
+	ProjectID    string                       `json:"-"`
 	Name         string                       `json:"name"`
 	VirtualHost  string                       `json:"virtualHost"`
 	OwnerID      int                          `json:"ownerId"`
@@ -89,23 +96,29 @@ type S3StorageContainerEncryption struct {
 }
 
 func listS3StorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_s3.listS3StorageContainer", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
 	region := d.EqualsQuals["region"].GetStringValue()
 
-	var containers []S3StorageContainer
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/storage", projectId, region), &containers)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var containers []S3StorageContainer
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/region/%s/storage", projectId, region), &containers)
+		if err != nil {
+			return err
+		}
+		for _, container := range containers {
+			container.ProjectID = projectId
+			d.StreamListItem(ctx, container)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_s3.listS3StorageContainer", err)
 		return nil, err
 	}
-	for _, container := range containers {
-		d.StreamListItem(ctx, container)
-	}
 	return nil, nil
 }
 
@@ -124,5 +137,6 @@ func getS3StorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.H
 		plugin.Logger(ctx).Error("ovh_cloud_storage_s3.getS3StorageContainer", err)
 		return nil, err
 	}
+	container.ProjectID = projectId
 	return container, nil
 }
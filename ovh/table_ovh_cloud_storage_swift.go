@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -14,18 +15,19 @@ func tableOvhCloudStorageSwift() *plugin.Table {
 		Name:        "ovh_cloud_storage_swift",
 		Description: "A Swift storage is the OpenStack object storage.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listSwiftStorageContainer,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getSwiftStorageContainer,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getSwiftStorageContainer,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -53,11 +55,13 @@ func tableOvhCloudStorageSwift() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Region of the container.",
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
 
 type SwiftStorageContainer struct {
+	ProjectID     string `json:"-"`
 	ID            string `json:"id"`
 	Name          string `json:"name"`
 	StoredObjects int    `json:"storedObjects"`
@@ -66,21 +70,27 @@ type SwiftStorageContainer struct {
 }
 
 func listSwiftStorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_swift.listSwiftStorageContainer", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var containers []SwiftStorageContainer
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/storage", projectId), &containers)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var containers []SwiftStorageContainer
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/storage", projectId), &containers)
+		if err != nil {
+			return err
+		}
+		for _, container := range containers {
+			container.ProjectID = projectId
+			d.StreamListItem(ctx, container)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_storage_swift.listSwiftStorageContainer", err)
 		return nil, err
 	}
-	for _, container := range containers {
-		d.StreamListItem(ctx, container)
-	}
 	return nil, nil
 }
 
@@ -99,5 +109,6 @@ func getSwiftStorageContainer(ctx context.Context, d *plugin.QueryData, _ *plugi
 		return nil, err
 	}
 	container.ID = id
+	container.ProjectID = projectId
 	return container, nil
 }
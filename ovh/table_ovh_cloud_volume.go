@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,19 @@ func tableOvhCloudVolume() *plugin.Table {
 		Name:        "ovh_cloud_volume",
 		Description: "A volume is an independent additional disk.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listVolume,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getVolume,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getVolume,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -85,11 +87,13 @@ func tableOvhCloudVolume() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Volume type (classic, high-speed, high-speed-gen2",
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
 
 type Volume struct {
+	ProjectID    string    `json:"-"`
 	ID           string    `json:"id"`
 	Name         string    `json:"name"`
 	Region       string    `json:"region"`
@@ -104,21 +108,27 @@ type Volume struct {
 }
 
 func listVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.listVolume", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var volumes []Volume
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/volume", projectId), &volumes)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var volumes []Volume
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/volume", projectId), &volumes)
+		if err != nil {
+			return err
+		}
+		for _, volume := range volumes {
+			volume.ProjectID = projectId
+			d.StreamListItem(ctx, volume)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.listVolume", err)
 		return nil, err
 	}
-	for _, volume := range volumes {
-		d.StreamListItem(ctx, volume)
-	}
 	return nil, nil
 }
 
@@ -136,5 +146,6 @@ func getVolume(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 		plugin.Logger(ctx).Error("ovh_cloud_volume.getVolume", err)
 		return nil, err
 	}
+	volume.ProjectID = projectId
 	return volume, nil
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
@@ -15,18 +16,19 @@ func tableOvhCloudVolumeSnapshot() *plugin.Table {
 		Name:        "ovh_cloud_volume_snapshot",
 		Description: "A volume snapshot a copy of the state of a storage volume at a particular point in time.",
 		List: &plugin.ListConfig{
-			KeyColumns: plugin.SingleColumn("project_id"),
+			KeyColumns: plugin.OptionalColumns([]string{"project_id"}),
 			Hydrate:    listVolumeSnapshot,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"project_id", "id"}),
-			Hydrate:    getVolumeSnapshot,
+			KeyColumns:   plugin.AllColumns([]string{"project_id", "id"}),
+			Hydrate:      getVolumeSnapshot,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
 				Name:        "project_id",
 				Type:        proto.ColumnType_STRING,
-				Transform:   transform.FromQual("project_id"),
+				Transform:   transform.FromField("ProjectID"),
 				Description: "Project ID.",
 			},
 			{
@@ -76,11 +78,13 @@ func tableOvhCloudVolumeSnapshot() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Volume Snapshot Plan Code.",
 			},
+			titleColumn(nil, "Name"),
 		},
 	}
 }
 
 type VolumeSnapShot struct {
+	ProjectID    string    `json:"-"`
 	ID           string    `json:"id"`
 	CreationDate time.Time `json:"creationDate"`
 	Name         string    `json:"name"`
@@ -93,21 +97,27 @@ type VolumeSnapShot struct {
 }
 
 func listVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.listVolumeSnapshot", "connection_error", err)
 		return nil, err
 	}
-	projectId := d.EqualsQuals["project_id"].GetStringValue()
-	var volumes []VolumeSnapShot
-	err = client.Get(fmt.Sprintf("/cloud/project/%s/volume/snapshot", projectId), &volumes)
+	err = forEachProject(ctx, d, client, func(client *ovh.Client, projectId string) error {
+		var volumes []VolumeSnapShot
+		err := client.Get(fmt.Sprintf("/cloud/project/%s/volume/snapshot", projectId), &volumes)
+		if err != nil {
+			return err
+		}
+		for _, volume := range volumes {
+			volume.ProjectID = projectId
+			d.StreamListItem(ctx, volume)
+		}
+		return nil
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_cloud_volume.listVolumeSnapshot", err)
 		return nil, err
 	}
-	for _, volume := range volumes {
-		d.StreamListItem(ctx, volume)
-	}
 	return nil, nil
 }
 
@@ -125,5 +135,6 @@ func getVolumeSnapshot(ctx context.Context, d *plugin.QueryData, _ *plugin.Hydra
 		plugin.Logger(ctx).Error("ovh_cloud_volume.getVolumeSnapshot", err)
 		return nil, err
 	}
+	volume.ProjectID = projectId
 	return volume, nil
 }
@@ -16,16 +16,20 @@ func tableOvhDedicatedServer(ctx context.Context) *plugin.Table {
 		Name:        "ovh_dedicated_server",
 		Description: "OVH Dedicated Server inventory with hardware and configuration details.",
 		List: &plugin.ListConfig{
-			Hydrate: listDedicatedServers,
+			Hydrate:    listDedicatedServers,
+			KeyColumns: iamTagsKeyColumns(),
 		},
 		HydrateConfig: []plugin.HydrateConfig{
 			{
-				Func: getDedicatedServer,
+				Func:           getDedicatedServer,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 			},
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.SingleColumn("name"),
-			Hydrate:    getDedicatedServer,
+			KeyColumns:   plugin.SingleColumn("name"),
+			Hydrate:      getDedicatedServer,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -196,6 +200,9 @@ func tableOvhDedicatedServer(ctx context.Context) *plugin.Table {
 				Transform:   transform.FromField("Iam.Urn"),
 				Hydrate:     getDedicatedServer,
 			},
+			titleColumn(getDedicatedServer, "Iam.DisplayName"),
+			akasColumn(getDedicatedServer, "Iam.Urn"),
+			tagsColumn(getDedicatedServer, "Iam.Tags"),
 		},
 	}
 }
@@ -203,14 +210,14 @@ func tableOvhDedicatedServer(ctx context.Context) *plugin.Table {
 //// LIST FUNCTION
 
 func listDedicatedServers(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_dedicated_server.listDedicatedServers", "connection_error", err)
 		return nil, err
 	}
 
 	var serverNames []string
-	if err := client.Get("/dedicated/server", &serverNames); err != nil {
+	if err := client.Get("/dedicated/server"+iamTagsQuery(d, "iamTags"), &serverNames); err != nil {
 		plugin.Logger(ctx).Error("ovh_dedicated_server.listDedicatedServers", "api_error", err)
 		return nil, err
 	}
@@ -279,7 +286,8 @@ type DedicatedServer struct {
 }
 
 type IAM struct {
-	DisplayName string `json:"displayName"`
-	Id          string `json:"id"`
-	Urn         string `json:"urn"`
+	DisplayName string            `json:"displayName"`
+	Id          string            `json:"id"`
+	Urn         string            `json:"urn"`
+	Tags        map[string]string `json:"tags"`
 }
@@ -0,0 +1,147 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhDNSRecord() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_dns_record",
+		Description: "A record of a DNS zone hosted by OVH.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"zone_name", "field_type", "sub_domain"}),
+			Hydrate:    listDNSRecord,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"zone_name", "id"}),
+			Hydrate:      getDNSRecord,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getDNSRecordInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "zone_name",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ZoneName"),
+				Description: "Name of the zone of the record.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "Record ID.",
+			},
+			{
+				Name:        "field_type",
+				Hydrate:     getDNSRecordInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the record (A, AAAA, CNAME, MX, TXT...).",
+			},
+			{
+				Name:        "sub_domain",
+				Hydrate:     getDNSRecordInfo,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SubDomain"),
+				Description: "Sub domain of the record, empty for the apex of the zone.",
+			},
+			{
+				Name:        "target",
+				Hydrate:     getDNSRecordInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Target of the record.",
+			},
+			{
+				Name:        "ttl",
+				Hydrate:     getDNSRecordInfo,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("TTL"),
+				Description: "TTL of the record (in seconds), 0 when the default TTL of the zone is used.",
+			},
+			titleColumn(getDNSRecordInfo, "Target"),
+		},
+	}
+}
+
+type DNSRecord struct {
+	ZoneName  string `json:"zone"`
+	ID        int64  `json:"id"`
+	FieldType string `json:"fieldType"`
+	SubDomain string `json:"subDomain"`
+	Target    string `json:"target"`
+	TTL       int    `json:"ttl"`
+}
+
+func listDNSRecord(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_record.listDNSRecord", "connection_error", err)
+		return nil, err
+	}
+
+	// The apex records have an empty sub domain, which the API does not
+	// filter on
+	values := url.Values{}
+	if fieldType := d.EqualsQuals["field_type"].GetStringValue(); fieldType != "" {
+		values.Set("fieldType", fieldType)
+	}
+	if subDomain := d.EqualsQuals["sub_domain"].GetStringValue(); subDomain != "" {
+		values.Set("subDomain", subDomain)
+	}
+	query := ""
+	if len(values) > 0 {
+		query = "?" + values.Encode()
+	}
+
+	err = forEachDNSZone(ctx, d, client, func(zoneName string) error {
+		var ids []int64
+		err := client.Get(fmt.Sprintf("/domain/zone/%s/record", zoneName)+query, &ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			d.StreamListItem(ctx, DNSRecord{ZoneName: zoneName, ID: id})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_record.listDNSRecord", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getDNSRecordInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	record := h.Item.(DNSRecord)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_record.getDNSRecordInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/domain/zone/%s/record/%d", record.ZoneName, record.ID), &record)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_record.getDNSRecordInfo", err)
+		return nil, err
+	}
+	return record, nil
+}
+
+func getDNSRecord(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = DNSRecord{
+		ZoneName: d.EqualsQuals["zone_name"].GetStringValue(),
+		ID:       d.EqualsQuals["id"].GetInt64Value(),
+	}
+	return getDNSRecordInfo(ctx, d, h)
+}
@@ -0,0 +1,160 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhDNSZone() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_dns_zone",
+		Description: "A DNS zone hosted by OVH.",
+		List: &plugin.ListConfig{
+			KeyColumns: iamTagsKeyColumns(),
+			Hydrate:    listDNSZone,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.SingleColumn("name"),
+			Hydrate:      getDNSZone,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getDNSZoneInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getDNSZoneDNSSEC,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the zone.",
+			},
+			{
+				Name:        "name_servers",
+				Hydrate:     getDNSZoneInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Name servers of the zone.",
+			},
+			{
+				Name:        "has_dns_anycast",
+				Hydrate:     getDNSZoneInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HasDNSAnycast"),
+				Description: "True if the zone is served by the DNS Anycast name servers.",
+			},
+			{
+				Name:        "dnssec_supported",
+				Hydrate:     getDNSZoneInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DNSSECSupported"),
+				Description: "True if DNSSEC can be enabled on the zone.",
+			},
+			{
+				Name:        "dnssec_status",
+				Hydrate:     getDNSZoneDNSSEC,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status"),
+				Description: "DNSSEC status of the zone (enabled, disabled, enableInProgress, disableInProgress).",
+			},
+			{
+				Name:        "last_update",
+				Hydrate:     getDNSZoneInfo,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the zone.",
+			},
+			titleColumn(getDNSZoneInfo, "Iam.DisplayName"),
+			akasColumn(getDNSZoneInfo, "Iam.URN"),
+			tagsColumn(getDNSZoneInfo, "Iam.Tags"),
+		},
+	}
+}
+
+type DNSZone struct {
+	Name            string     `json:"name"`
+	NameServers     []string   `json:"nameServers"`
+	HasDNSAnycast   bool       `json:"hasDnsAnycast"`
+	DNSSECSupported bool       `json:"dnssecSupported"`
+	LastUpdate      *time.Time `json:"lastUpdate"`
+	Iam             Iam        `json:"iam"`
+}
+
+func listDNSZone(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone.listDNSZone", "connection_error", err)
+		return nil, err
+	}
+
+	var names []string
+	err = client.Get("/domain/zone"+iamTagsQuery(d, "iamTags"), &names)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone.listDNSZone", err)
+		return nil, err
+	}
+
+	for _, name := range names {
+		d.StreamListItem(ctx, DNSZone{Name: name})
+	}
+	return nil, nil
+}
+
+// forEachDNSZone calls listFunc for each DNS zone of the account, or only for
+// the zone given in the zone_name qual. The zones removed or expired while being
+// listed are skipped.
+func forEachDNSZone(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(zoneName string) error) error {
+	var zoneNames []string
+	if zoneName := d.EqualsQuals["zone_name"].GetStringValue(); zoneName != "" {
+		zoneNames = []string{zoneName}
+	} else if err := client.Get("/domain/zone", &zoneNames); err != nil {
+		return err
+	}
+	for _, zoneName := range zoneNames {
+		if ctx.Err() != nil || rowsRemaining(ctx, d) == 0 {
+			break
+		}
+		if err := listFunc(zoneName); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func getDNSZoneInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	zone := h.Item.(DNSZone)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone.getDNSZoneInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/domain/zone/%s", zone.Name), &zone)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone.getDNSZoneInfo", err)
+		return nil, err
+	}
+	return zone, nil
+}
+
+func getDNSZone(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = DNSZone{Name: d.EqualsQuals["name"].GetStringValue()}
+	return getDNSZoneInfo(ctx, d, h)
+}
+
+func getDNSZoneDNSSEC(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	zone := h.Item.(DNSZone)
+	return getZoneDNSSEC(ctx, d, "ovh_dns_zone.getDNSZoneDNSSEC", zone.Name)
+}
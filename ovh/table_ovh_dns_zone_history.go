@@ -0,0 +1,113 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhDNSZoneHistory() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_dns_zone_history",
+		Description: "A previous version of a DNS zone hosted by OVH.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.KeyColumnSlice{
+				{Name: "zone_name", Require: plugin.Optional},
+				dateRangeKeyColumn("creation_date"),
+			},
+			Hydrate: listDNSZoneHistory,
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getDNSZoneHistoryInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "zone_name",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ZoneName"),
+				Description: "Name of the zone.",
+			},
+			{
+				Name:        "creation_date",
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Date of the version of the zone.",
+			},
+			{
+				Name:        "zone_file_url",
+				Hydrate:     getDNSZoneHistoryInfo,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ZoneFileURL"),
+				Description: "URL to download the zone file of the version.",
+			},
+			{
+				Name:        "title",
+				Type:        proto.ColumnType_STRING,
+				Description: "Title of the resource.",
+				Transform:   transform.From(dnsZoneHistoryTitle),
+			},
+		},
+	}
+}
+
+// dnsZoneHistoryTitle returns the title of a version of a zone: the zone and
+// the date of the version.
+func dnsZoneHistoryTitle(_ context.Context, d *transform.TransformData) (interface{}, error) {
+	history := d.HydrateItem.(DNSZoneHistory)
+	return fmt.Sprintf("%s %s", history.ZoneName, history.CreationDate.Format(time.RFC3339)), nil
+}
+
+type DNSZoneHistory struct {
+	ZoneName     string    `json:"-"`
+	CreationDate time.Time `json:"creationDate"`
+	ZoneFileURL  string    `json:"zoneFileUrl"`
+}
+
+func listDNSZoneHistory(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone_history.listDNSZoneHistory", "connection_error", err)
+		return nil, err
+	}
+	query := dateRangeQuery(d, "creation_date", "creationDate")
+	err = forEachDNSZone(ctx, d, client, func(zoneName string) error {
+		var dates []time.Time
+		err := client.Get(fmt.Sprintf("/domain/zone/%s/history", zoneName)+query, &dates)
+		if err != nil {
+			return err
+		}
+		for _, date := range dates {
+			d.StreamListItem(ctx, DNSZoneHistory{ZoneName: zoneName, CreationDate: date})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone_history.listDNSZoneHistory", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getDNSZoneHistoryInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	history := h.Item.(DNSZoneHistory)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone_history.getDNSZoneHistoryInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/domain/zone/%s/history/%s", history.ZoneName, history.CreationDate.Format(time.RFC3339)), &history)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_dns_zone_history.getDNSZoneHistoryInfo", err)
+		return nil, err
+	}
+	return history, nil
+}
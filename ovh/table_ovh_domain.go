@@ -0,0 +1,327 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhDomain() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_domain",
+		Description: "A domain name registered at OVH.",
+		List: &plugin.ListConfig{
+			KeyColumns: iamTagsKeyColumns(),
+			Hydrate:    listDomain,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.SingleColumn("name"),
+			Hydrate:      getDomain,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getDomainInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getDomainServiceInfos,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getDomainNameServers,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getDomainDNSSEC,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getDomainOwnerContact,
+				Depends:        []plugin.HydrateFunc{getDomainInfo},
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Domain name.",
+			},
+			{
+				Name:        "offer",
+				Hydrate:     getDomainInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Offer of the domain (gold, platinum, diamond).",
+			},
+			{
+				Name:        "transfer_lock_status",
+				Hydrate:     getDomainInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Transfer lock status (locked, locking, unavailable, unlocked, unlocking).",
+			},
+			{
+				Name:        "name_server_type",
+				Hydrate:     getDomainInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the name servers: hosted (by OVH) or external.",
+			},
+			{
+				Name:        "name_servers",
+				Hydrate:     getDomainNameServers,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+				Description: "Name servers of the domain, with their glue IP.",
+			},
+			{
+				Name:        "dnssec_supported",
+				Hydrate:     getDomainInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("DNSSECSupported"),
+				Description: "True if the extension of the domain supports DNSSEC.",
+			},
+			{
+				Name:        "dnssec_status",
+				Hydrate:     getDomainDNSSEC,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("Status"),
+				Description: "DNSSEC status of the zone of the domain hosted by OVH (enabled, disabled, enableInProgress, disableInProgress), null when the zone is not hosted by OVH.",
+			},
+			{
+				Name:        "whois_owner",
+				Hydrate:     getDomainInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "ID of the owner contact of the domain.",
+			},
+			{
+				Name:        "owner_contact",
+				Hydrate:     getDomainOwnerContact,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+				Description: "Owner contact of the domain: name, organisation, email, address...",
+			},
+			{
+				Name:        "status",
+				Hydrate:     getDomainServiceInfos,
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the service (ok, expired, inCreation, unPaid, pendingDebt).",
+			},
+			{
+				Name:        "creation_date",
+				Hydrate:     getDomainServiceInfos,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Creation").NullIfZero(),
+				Description: "Creation date of the domain.",
+			},
+			{
+				Name:        "expiration_date",
+				Hydrate:     getDomainServiceInfos,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Transform:   transform.FromField("Expiration").NullIfZero(),
+				Description: "Expiration date of the domain.",
+			},
+			{
+				Name:        "auto_renew",
+				Hydrate:     getDomainServiceInfos,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Renew.Automatic"),
+				Description: "True if the domain is renewed automatically.",
+			},
+			{
+				Name:        "renew",
+				Hydrate:     getDomainServiceInfos,
+				Type:        proto.ColumnType_JSON,
+				Description: "Renewal mode of the domain: automatic, period, manual payment, deletion at expiration.",
+			},
+			{
+				Name:        "last_update",
+				Hydrate:     getDomainInfo,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Last update date of the domain.",
+			},
+			titleColumn(getDomainInfo, "Iam.DisplayName"),
+			akasColumn(getDomainInfo, "Iam.URN"),
+			tagsColumn(getDomainInfo, "Iam.Tags"),
+		},
+	}
+}
+
+type Domain struct {
+	Name               string     `json:"domain"`
+	Offer              string     `json:"offer"`
+	TransferLockStatus string     `json:"transferLockStatus"`
+	NameServerType     string     `json:"nameServerType"`
+	DNSSECSupported    bool       `json:"dnssecSupported"`
+	WhoisOwner         string     `json:"whoisOwner"`
+	LastUpdate         *time.Time `json:"lastUpdate"`
+	Iam                Iam        `json:"iam"`
+}
+
+type DomainNameServer struct {
+	ID       int64  `json:"id"`
+	Host     string `json:"host"`
+	IP       string `json:"ip"`
+	IsUsed   bool   `json:"isUsed"`
+	ToDelete bool   `json:"toDelete"`
+}
+
+// ServiceInfos are the billing information of a service.
+type ServiceInfos struct {
+	Status     string       `json:"status"`
+	Creation   string       `json:"creation"`
+	Expiration string       `json:"expiration"`
+	Renew      ServiceRenew `json:"renew"`
+}
+
+type ServiceRenew struct {
+	Automatic          bool `json:"automatic"`
+	DeleteAtExpiration bool `json:"deleteAtExpiration"`
+	Forced             bool `json:"forced"`
+	ManualPayment      bool `json:"manualPayment"`
+	Period             int  `json:"period"`
+}
+
+type DNSSEC struct {
+	Status string `json:"status"`
+}
+
+func listDomain(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.listDomain", "connection_error", err)
+		return nil, err
+	}
+
+	var names []string
+	err = client.Get("/domain"+iamTagsQuery(d, "iamTags"), &names)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.listDomain", err)
+		return nil, err
+	}
+
+	for _, name := range names {
+		d.StreamListItem(ctx, Domain{Name: name})
+	}
+	return nil, nil
+}
+
+func getDomainInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain := h.Item.(Domain)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/domain/%s", domain.Name), &domain)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainInfo", err)
+		return nil, err
+	}
+	return domain, nil
+}
+
+func getDomain(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = Domain{Name: d.EqualsQuals["name"].GetStringValue()}
+	return getDomainInfo(ctx, d, h)
+}
+
+func getDomainServiceInfos(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain := h.Item.(Domain)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainServiceInfos", "connection_error", err)
+		return nil, err
+	}
+
+	var serviceInfos ServiceInfos
+	err = client.Get(fmt.Sprintf("/domain/%s/serviceInfos", domain.Name), &serviceInfos)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainServiceInfos", err)
+		return nil, err
+	}
+	return serviceInfos, nil
+}
+
+func getDomainNameServers(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain := h.Item.(Domain)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainNameServers", "connection_error", err)
+		return nil, err
+	}
+
+	var ids []int64
+	err = client.Get(fmt.Sprintf("/domain/%s/nameServer", domain.Name), &ids)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainNameServers", err)
+		return nil, err
+	}
+	nameServers := []DomainNameServer{}
+	for _, id := range ids {
+		var nameServer DomainNameServer
+		err = client.Get(fmt.Sprintf("/domain/%s/nameServer/%d", domain.Name, id), &nameServer)
+		if err != nil {
+			plugin.Logger(ctx).Error("ovh_domain.getDomainNameServers", err)
+			return nil, err
+		}
+		nameServers = append(nameServers, nameServer)
+	}
+	return nameServers, nil
+}
+
+func getDomainDNSSEC(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain := h.Item.(Domain)
+	return getZoneDNSSEC(ctx, d, "ovh_domain.getDomainDNSSEC", domain.Name)
+}
+
+// getZoneDNSSEC returns the DNSSEC status of a DNS zone hosted by OVH. The
+// API answers 404 when there is no zone, ignored by the hydrate config.
+func getZoneDNSSEC(ctx context.Context, d *plugin.QueryData, logName, zoneName string) (interface{}, error) {
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error(logName, "connection_error", err)
+		return nil, err
+	}
+
+	var dnssec DNSSEC
+	err = client.Get(fmt.Sprintf("/domain/zone/%s/dnssec", zoneName), &dnssec)
+	if err != nil {
+		plugin.Logger(ctx).Error(logName, err)
+		return nil, err
+	}
+	return dnssec, nil
+}
+
+func getDomainOwnerContact(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	domain, ok := h.HydrateResults["getDomainInfo"].(Domain)
+	if !ok || domain.WhoisOwner == "" {
+		return nil, nil
+	}
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainOwnerContact", "connection_error", err)
+		return nil, err
+	}
+
+	var contact map[string]interface{}
+	err = client.Get(fmt.Sprintf("/me/contact/%s", domain.WhoisOwner), &contact)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_domain.getDomainOwnerContact", err)
+		return nil, err
+	}
+	return contact, nil
+}
@@ -13,7 +13,8 @@ func tableOvhIamResource() *plugin.Table {
 		Name:        "ovh_iam_resource",
 		Description: "IAM resources in the OVH account.",
 		List: &plugin.ListConfig{
-			Hydrate: listIamResource,
+			Hydrate:    listIamResource,
+			KeyColumns: iamTagsKeyColumns(),
 		},
 		Columns: []*plugin.Column{
 			{
@@ -52,6 +53,8 @@ func tableOvhIamResource() *plugin.Table {
 				Type:        proto.ColumnType_JSON,
 				Description: "Resource tags. Tags that were internally computed are prefixed with ovh:.",
 			},
+			titleColumn(nil, "DisplayName"),
+			akasColumn(nil, "URN"),
 		},
 	}
 }
@@ -67,22 +70,19 @@ type IamResource struct {
 }
 
 func listIamResource(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_iam_resource.listIamResource", "connection_error", err)
 		return nil, err
 	}
 
-	var resources []IamResource
-	err = client.Get("/v2/iam/resource", &resources)
+	err = listPages(ctx, d, client, "/v2/iam/resource"+iamTagsQuery(d, "tags"), func(resource IamResource) {
+		d.StreamListItem(ctx, resource)
+	})
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_iam_resource.listIamResource", err)
 		return nil, err
 	}
 
-	for _, resource := range resources {
-		d.StreamListItem(ctx, resource)
-	}
-
 	return nil, nil
 }
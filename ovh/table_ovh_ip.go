@@ -0,0 +1,257 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIP() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_ip",
+		Description: "An IP block of the account.",
+		List: &plugin.ListConfig{
+			KeyColumns: append(iamTagsKeyColumns(), plugin.OptionalColumns([]string{"type", "routed_to"})...),
+			Hydrate:    listIP,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.SingleColumn("ip"),
+			Hydrate:      getIP,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+			{
+				Func:           getIPFirewalls,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "ip",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("IP"),
+				Description: "IP block (e.g. 203.0.113.16/28).",
+			},
+			{
+				Name:        "type",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the block (cloud, dedicated, failover, hosted_ssl, loadBalancing, mail, overthebox, pcc, pci, private, vpn, vps, xdsl...).",
+			},
+			{
+				Name:        "description",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the block.",
+			},
+			{
+				Name:        "routed_to",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("RoutedTo.ServiceName").NullIfZero(),
+				Description: "Service name of the service the block is routed to (e.g. a dedicated server or a cloud project).",
+			},
+			{
+				Name:        "country",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Country of geolocation of the block.",
+			},
+			{
+				Name:        "campus",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Campus where the block is announced (e.g. GRA, RBX, BHS).",
+			},
+			{
+				Name:        "version",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_INT,
+				Description: "IP version of the block (4 or 6).",
+			},
+			{
+				Name:        "is_additional_ip",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("IsAdditionalIP"),
+				Description: "True if the block is an additional IP, which can be moved between services.",
+			},
+			{
+				Name:        "can_be_terminated",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("CanBeTerminated"),
+				Description: "True if the block can be terminated.",
+			},
+			{
+				Name:        "organisation_id",
+				Hydrate:     getIPInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "ID of the organisation of the block in the RIPE or ARIN database.",
+			},
+			{
+				Name:        "firewall",
+				Hydrate:     getIPFirewalls,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromValue(),
+				Description: "IPs of the block on the edge network firewall, with their state.",
+			},
+			titleColumn(getIPInfo, "Iam.DisplayName"),
+			akasColumn(getIPInfo, "Iam.URN"),
+			tagsColumn(getIPInfo, "Iam.Tags"),
+		},
+	}
+}
+
+type IP struct {
+	IP              string     `json:"ip"`
+	Type            string     `json:"type"`
+	Description     string     `json:"description"`
+	RoutedTo        IPRoutedTo `json:"routedTo"`
+	Country         string     `json:"country"`
+	Campus          string     `json:"campus"`
+	Version         int        `json:"version"`
+	IsAdditionalIP  bool       `json:"isAdditionalIp"`
+	CanBeTerminated bool       `json:"canBeTerminated"`
+	OrganisationID  string     `json:"organisationId"`
+	Iam             Iam        `json:"iam"`
+}
+
+type IPRoutedTo struct {
+	ServiceName string `json:"serviceName"`
+}
+
+type IPFirewall struct {
+	IPOnFirewall string `json:"ipOnFirewall"`
+	Enabled      bool   `json:"enabled"`
+	State        string `json:"state"`
+}
+
+func listIP(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip.listIP", "connection_error", err)
+		return nil, err
+	}
+
+	values := url.Values{}
+	if ipType := d.EqualsQuals["type"].GetStringValue(); ipType != "" {
+		values.Set("type", ipType)
+	}
+	if routedTo := d.EqualsQuals["routed_to"].GetStringValue(); routedTo != "" {
+		values.Set("routedTo.serviceName", routedTo)
+	}
+	query := iamTagsQuery(d, "iamTags")
+	if len(values) > 0 {
+		if query == "" {
+			query = "?" + values.Encode()
+		} else {
+			query += "&" + values.Encode()
+		}
+	}
+
+	var ips []string
+	err = client.Get("/ip"+query, &ips)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip.listIP", err)
+		return nil, err
+	}
+
+	for _, ip := range ips {
+		d.StreamListItem(ctx, IP{IP: ip})
+	}
+	return nil, nil
+}
+
+// forEachIP calls listFunc for each IP block of the account, or only for the
+// one given in the ip qual. The blocks removed while being listed (404) are
+// skipped.
+func forEachIP(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(ip string) error) error {
+	var ips []string
+	if ip := d.EqualsQuals["ip"].GetInetValue().GetCidr(); ip != "" {
+		ips = []string{ip}
+	} else if err := client.Get("/ip", &ips); err != nil {
+		return err
+	}
+	for _, ip := range ips {
+		if ctx.Err() != nil || rowsRemaining(ctx, d) == 0 {
+			break
+		}
+		if err := listFunc(ip); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// ipPath returns the path of an IP block, whose slash must be escaped.
+func ipPath(ip string) string {
+	return "/ip/" + url.PathEscape(ip)
+}
+
+func getIPInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ip := h.Item.(IP)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip.getIPInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(ipPath(ip.IP), &ip)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip.getIPInfo", err)
+		return nil, err
+	}
+	return ip, nil
+}
+
+func getIP(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = IP{IP: d.EqualsQuals["ip"].GetInetValue().GetCidr()}
+	return getIPInfo(ctx, d, h)
+}
+
+// listIPFirewalls returns the IPs of a block on the edge network firewall.
+func listIPFirewalls(client *ovh.Client, ip string) ([]IPFirewall, error) {
+	var ipsOnFirewall []string
+	if err := client.Get(ipPath(ip)+"/firewall", &ipsOnFirewall); err != nil {
+		return nil, err
+	}
+	firewalls := []IPFirewall{}
+	for _, ipOnFirewall := range ipsOnFirewall {
+		var firewall IPFirewall
+		if err := client.Get(fmt.Sprintf("%s/firewall/%s", ipPath(ip), ipOnFirewall), &firewall); err != nil {
+			return nil, err
+		}
+		firewalls = append(firewalls, firewall)
+	}
+	return firewalls, nil
+}
+
+func getIPFirewalls(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ip := h.Item.(IP)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip.getIPFirewalls", "connection_error", err)
+		return nil, err
+	}
+
+	firewalls, err := listIPFirewalls(client, ip.IP)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip.getIPFirewalls", err)
+		return nil, err
+	}
+	return firewalls, nil
+}
@@ -0,0 +1,194 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPFirewallRule() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_ip_firewall_rule",
+		Description: "A rule of the edge network firewall of an IP.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"ip"}),
+			Hydrate:    listIPFirewallRule,
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPFirewallRuleInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "ip",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("IP"),
+				Description: "IP block.",
+			},
+			{
+				Name:        "ip_on_firewall",
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("IPOnFirewall"),
+				Description: "IP of the block protected by the firewall.",
+			},
+			{
+				Name:        "firewall_enabled",
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("FirewallEnabled"),
+				Description: "True if the firewall of the IP is enabled.",
+			},
+			{
+				Name:        "sequence",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Sequence"),
+				Description: "Sequence of the rule, the rules are applied from the lowest.",
+			},
+			{
+				Name:        "action",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Action of the rule (permit, deny).",
+			},
+			{
+				Name:        "protocol",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Protocol of the rule (ah, esp, gre, icmp, ipv4, tcp, udp).",
+			},
+			{
+				Name:        "source",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Source of the rule, any when not set.",
+			},
+			{
+				Name:        "source_port",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Source port of the rule.",
+			},
+			{
+				Name:        "destination",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Destination of the rule.",
+			},
+			{
+				Name:        "destination_port",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Destination port of the rule.",
+			},
+			{
+				Name:        "fragments",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Fragments"),
+				Description: "True if the rule matches the fragmented packets.",
+			},
+			{
+				Name:        "tcp_option",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("TCPOption").NullIfZero(),
+				Description: "TCP option of the rule (established, syn).",
+			},
+			{
+				Name:        "rule",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the rule.",
+			},
+			{
+				Name:        "state",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "State of the rule (creationPending, ok, removalPending).",
+			},
+			{
+				Name:        "creation_date",
+				Hydrate:     getIPFirewallRuleInfo,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Creation date of the rule.",
+			},
+			titleColumn(getIPFirewallRuleInfo, "Rule"),
+		},
+	}
+}
+
+type IPFirewallRule struct {
+	IP              string     `json:"-"`
+	IPOnFirewall    string     `json:"-"`
+	FirewallEnabled bool       `json:"-"`
+	Sequence        int        `json:"sequence"`
+	Action          string     `json:"action"`
+	Protocol        string     `json:"protocol"`
+	Source          string     `json:"source"`
+	SourcePort      string     `json:"sourcePort"`
+	Destination     string     `json:"destination"`
+	DestinationPort string     `json:"destinationPort"`
+	Fragments       bool       `json:"fragments"`
+	TCPOption       string     `json:"tcpOption"`
+	Rule            string     `json:"rule"`
+	State           string     `json:"state"`
+	CreationDate    *time.Time `json:"creationDate"`
+}
+
+func listIPFirewallRule(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_firewall_rule.listIPFirewallRule", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIP(ctx, d, client, func(ip string) error {
+		firewalls, err := listIPFirewalls(client, ip)
+		if err != nil {
+			return err
+		}
+		for _, firewall := range firewalls {
+			var sequences []int
+			err := client.Get(fmt.Sprintf("%s/firewall/%s/rule", ipPath(ip), firewall.IPOnFirewall), &sequences)
+			if err != nil {
+				return err
+			}
+			for _, sequence := range sequences {
+				d.StreamListItem(ctx, IPFirewallRule{
+					IP:              ip,
+					IPOnFirewall:    firewall.IPOnFirewall,
+					FirewallEnabled: firewall.Enabled,
+					Sequence:        sequence,
+				})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_firewall_rule.listIPFirewallRule", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPFirewallRuleInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	rule := h.Item.(IPFirewallRule)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_firewall_rule.getIPFirewallRuleInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("%s/firewall/%s/rule/%d", ipPath(rule.IP), rule.IPOnFirewall, rule.Sequence), &rule)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_firewall_rule.getIPFirewallRuleInfo", err)
+		return nil, err
+	}
+	return rule, nil
+}
@@ -0,0 +1,112 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPMitigation() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_ip_mitigation",
+		Description: "The anti-DDoS mitigation of an IP.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"ip"}),
+			Hydrate:    listIPMitigation,
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPMitigationInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "ip",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("IP"),
+				Description: "IP block.",
+			},
+			{
+				Name:        "ip_on_mitigation",
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("IPOnMitigation"),
+				Description: "IP of the block under mitigation.",
+			},
+			{
+				Name:        "auto",
+				Hydrate:     getIPMitigationInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Auto"),
+				Description: "True if the mitigation was triggered automatically by an attack.",
+			},
+			{
+				Name:        "permanent",
+				Hydrate:     getIPMitigationInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Permanent"),
+				Description: "True if the mitigation is permanent.",
+			},
+			{
+				Name:        "state",
+				Hydrate:     getIPMitigationInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "State of the mitigation (creationPending, ok, removalPending).",
+			},
+			titleColumn(nil, "IPOnMitigation"),
+		},
+	}
+}
+
+type IPMitigation struct {
+	IP             string `json:"-"`
+	IPOnMitigation string `json:"ipOnMitigation"`
+	Auto           bool   `json:"auto"`
+	Permanent      bool   `json:"permanent"`
+	State          string `json:"state"`
+}
+
+func listIPMitigation(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_mitigation.listIPMitigation", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIP(ctx, d, client, func(ip string) error {
+		var ipsOnMitigation []string
+		err := client.Get(ipPath(ip)+"/mitigation", &ipsOnMitigation)
+		if err != nil {
+			return err
+		}
+		for _, ipOnMitigation := range ipsOnMitigation {
+			d.StreamListItem(ctx, IPMitigation{IP: ip, IPOnMitigation: ipOnMitigation})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_mitigation.listIPMitigation", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPMitigationInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	mitigation := h.Item.(IPMitigation)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_mitigation.getIPMitigationInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("%s/mitigation/%s", ipPath(mitigation.IP), mitigation.IPOnMitigation), &mitigation)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_mitigation.getIPMitigationInfo", err)
+		return nil, err
+	}
+	return mitigation, nil
+}
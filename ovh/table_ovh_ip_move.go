@@ -0,0 +1,86 @@
+package ovh
+
+import (
+	"context"
+	"sort"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPMove() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_ip_move",
+		Description: "A service an IP block can be moved to.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"ip"}),
+			Hydrate:    listIPMove,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "ip",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("IP"),
+				Description: "IP block.",
+			},
+			{
+				Name:        "destination_type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the destination service (cloudProject, dedicatedCloud, dedicatedServer, hostingReseller, ipLoadbalancing, vps).",
+			},
+			{
+				Name:        "service",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the destination.",
+			},
+			{
+				Name:        "nexthop",
+				Type:        proto.ColumnType_JSON,
+				Description: "Possible next hops of the block on the destination.",
+			},
+			titleColumn(nil, "Service"),
+		},
+	}
+}
+
+type IPMove struct {
+	IP              string   `json:"-"`
+	DestinationType string   `json:"-"`
+	Service         string   `json:"service"`
+	Nexthop         []string `json:"nexthop"`
+}
+
+func listIPMove(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_move.listIPMove", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIP(ctx, d, client, func(ip string) error {
+		// The destinations are grouped by type
+		var destinations map[string][]IPMove
+		err := client.Get(ipPath(ip)+"/move", &destinations)
+		if err != nil {
+			return err
+		}
+		destinationTypes := make([]string, 0, len(destinations))
+		for destinationType := range destinations {
+			destinationTypes = append(destinationTypes, destinationType)
+		}
+		sort.Strings(destinationTypes)
+		for _, destinationType := range destinationTypes {
+			for _, move := range destinations[destinationType] {
+				move.IP = ip
+				move.DestinationType = destinationType
+				d.StreamListItem(ctx, move)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_move.listIPMove", err)
+		return nil, err
+	}
+	return nil, nil
+}
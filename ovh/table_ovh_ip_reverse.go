@@ -0,0 +1,96 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPReverse() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_ip_reverse",
+		Description: "A reverse DNS of an IP of an IP block.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"ip"}),
+			Hydrate:    listIPReverse,
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPReverseInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "ip",
+				Type:        proto.ColumnType_CIDR,
+				Transform:   transform.FromField("IP"),
+				Description: "IP block.",
+			},
+			{
+				Name:        "ip_reverse",
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("IPReverse"),
+				Description: "IP of the block with a reverse DNS.",
+			},
+			{
+				Name:        "reverse",
+				Hydrate:     getIPReverseInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Reverse DNS of the IP.",
+			},
+			titleColumn(getIPReverseInfo, "Reverse"),
+		},
+	}
+}
+
+type IPReverse struct {
+	IP        string `json:"-"`
+	IPReverse string `json:"ipReverse"`
+	Reverse   string `json:"reverse"`
+}
+
+func listIPReverse(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_reverse.listIPReverse", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIP(ctx, d, client, func(ip string) error {
+		var ipReverses []string
+		err := client.Get(ipPath(ip)+"/reverse", &ipReverses)
+		if err != nil {
+			return err
+		}
+		for _, ipReverse := range ipReverses {
+			d.StreamListItem(ctx, IPReverse{IP: ip, IPReverse: ipReverse})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_reverse.listIPReverse", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPReverseInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	reverse := h.Item.(IPReverse)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_reverse.getIPReverseInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("%s/reverse/%s", ipPath(reverse.IP), reverse.IPReverse), &reverse)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_ip_reverse.getIPReverseInfo", err)
+		return nil, err
+	}
+	return reverse, nil
+}
@@ -0,0 +1,215 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPLB() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_iplb",
+		Description: "An IP Load Balancer service.",
+		List: &plugin.ListConfig{
+			KeyColumns: iamTagsKeyColumns(),
+			Hydrate:    listIPLB,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.SingleColumn("service_name"),
+			Hydrate:      getIPLB,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPLBInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the load balancer (e.g. loadbalancer-xxx).",
+			},
+			{
+				Name:        "display_name",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the load balancer.",
+			},
+			{
+				Name:        "ipv4",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("IPv4"),
+				Description: "IPv4 of the load balancer.",
+			},
+			{
+				Name:        "ipv6",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_INET,
+				Transform:   transform.FromField("IPv6").NullIfZero(),
+				Description: "IPv6 of the load balancer.",
+			},
+			{
+				Name:        "state",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "State of the load balancer (ok, blacklisted, suspended, deleted).",
+			},
+			{
+				Name:        "offer",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Offer of the load balancer.",
+			},
+			{
+				Name:        "zone",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Zones (datacenters) where the load balancer is deployed.",
+			},
+			{
+				Name:        "ssl_configuration",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("SSLConfiguration").NullIfZero(),
+				Description: "Ciphers of the SSL frontends (intermediate, modern...).",
+			},
+			{
+				Name:        "vrack_eligibility",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("VrackEligibility"),
+				Description: "True if the load balancer can be attached to a vRack.",
+			},
+			{
+				Name:        "vrack_name",
+				Hydrate:     getIPLBInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the vRack of the load balancer.",
+			},
+			titleColumn(getIPLBInfo, "Iam.DisplayName"),
+			akasColumn(getIPLBInfo, "Iam.URN"),
+			tagsColumn(getIPLBInfo, "Iam.Tags"),
+		},
+	}
+}
+
+type IPLB struct {
+	ServiceName      string   `json:"serviceName"`
+	DisplayName      string   `json:"displayName"`
+	IPv4             string   `json:"ipLoadbalancing"`
+	IPv6             string   `json:"ipv6"`
+	State            string   `json:"state"`
+	Offer            string   `json:"offer"`
+	Zone             []string `json:"zone"`
+	SSLConfiguration string   `json:"sslConfiguration"`
+	VrackEligibility bool     `json:"vrackEligibility"`
+	VrackName        string   `json:"vrackName"`
+	Iam              Iam      `json:"iam"`
+}
+
+func listIPLB(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb.listIPLB", "connection_error", err)
+		return nil, err
+	}
+
+	var serviceNames []string
+	err = client.Get("/ipLoadbalancing"+iamTagsQuery(d, "iamTags"), &serviceNames)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb.listIPLB", err)
+		return nil, err
+	}
+
+	for _, serviceName := range serviceNames {
+		d.StreamListItem(ctx, IPLB{ServiceName: serviceName})
+	}
+	return nil, nil
+}
+
+func getIPLBInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	iplb := h.Item.(IPLB)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb.getIPLBInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/ipLoadbalancing/%s", iplb.ServiceName), &iplb)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb.getIPLBInfo", err)
+		return nil, err
+	}
+	return iplb, nil
+}
+
+func getIPLB(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = IPLB{ServiceName: d.EqualsQuals["service_name"].GetStringValue()}
+	return getIPLBInfo(ctx, d, h)
+}
+
+// Protocols of the frontends, farms and servers of a load balancer. The
+// routes exist only for http and tcp.
+var (
+	iplbTypes      = []string{"http", "tcp", "udp"}
+	iplbRouteTypes = []string{"http", "tcp"}
+)
+
+// forEachIPLB calls listFunc for each IP Load Balancer of the account, or only
+// for the one given in the service_name qual. The load balancers removed or
+// expired while being listed are skipped.
+func forEachIPLB(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(serviceName string) error) error {
+	var serviceNames []string
+	if serviceName := d.EqualsQuals["service_name"].GetStringValue(); serviceName != "" {
+		serviceNames = []string{serviceName}
+	} else if err := client.Get("/ipLoadbalancing", &serviceNames); err != nil {
+		return err
+	}
+	for _, serviceName := range serviceNames {
+		if ctx.Err() != nil || rowsRemaining(ctx, d) == 0 {
+			break
+		}
+		if err := listFunc(serviceName); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// forEachIPLBType calls listFunc for each IP Load Balancer of forEachIPLB and
+// each of the types, or only for the type given in the type qual. The types
+// which a load balancer does not have (404) are skipped.
+func forEachIPLBType(ctx context.Context, d *plugin.QueryData, client *ovh.Client, types []string, listFunc func(serviceName, iplbType string) error) error {
+	if iplbType := d.EqualsQuals["type"].GetStringValue(); iplbType != "" {
+		if !slices.Contains(types, iplbType) {
+			return nil
+		}
+		types = []string{iplbType}
+	}
+	return forEachIPLB(ctx, d, client, func(serviceName string) error {
+		for _, iplbType := range types {
+			if err := listFunc(serviceName, iplbType); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// listIPLBIds returns the IDs of the resources of a load balancer at the path
+// (e.g. http/frontend).
+func listIPLBIds(client *ovh.Client, serviceName, path string) ([]int64, error) {
+	var ids []int64
+	err := client.Get(fmt.Sprintf("/ipLoadbalancing/%s/%s", serviceName, path), &ids)
+	return ids, err
+}
@@ -0,0 +1,154 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+)
+
+func tableOvhIPLBFarm() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_iplb_farm",
+		Description: "A farm (backend) of servers of an IP Load Balancer.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"service_name", "type"}),
+			Hydrate:    listIPLBFarm,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"service_name", "type", "id"}),
+			Hydrate:      getIPLBFarm,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPLBFarmInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the load balancer.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Protocol of the farm (http, tcp or udp).",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "Farm ID.",
+			},
+			{
+				Name:        "display_name",
+				Hydrate:     getIPLBFarmInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the farm.",
+			},
+			{
+				Name:        "zone",
+				Hydrate:     getIPLBFarmInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Zone of the farm (all for all the zones).",
+			},
+			{
+				Name:        "port",
+				Hydrate:     getIPLBFarmInfo,
+				Type:        proto.ColumnType_INT,
+				Description: "Port of the servers, when not set on the servers.",
+			},
+			{
+				Name:        "balance",
+				Hydrate:     getIPLBFarmInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Load balancing algorithm (roundrobin, first, leastconn, source, uri).",
+			},
+			{
+				Name:        "stickiness",
+				Hydrate:     getIPLBFarmInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Stickiness of the sessions (cookie, sourceIp), null when disabled.",
+			},
+			{
+				Name:        "vrack_network_id",
+				Hydrate:     getIPLBFarmInfo,
+				Type:        proto.ColumnType_INT,
+				Description: "ID of the vRack network of the servers.",
+			},
+			{
+				Name:        "probe",
+				Hydrate:     getIPLBFarmInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Health check of the servers.",
+			},
+			titleColumn(getIPLBFarmInfo, "DisplayName"),
+		},
+	}
+}
+
+type IPLBFarm struct {
+	ServiceName    string                 `json:"-"`
+	Type           string                 `json:"-"`
+	ID             int64                  `json:"farmId"`
+	DisplayName    string                 `json:"displayName"`
+	Zone           string                 `json:"zone"`
+	Port           int                    `json:"port"`
+	Balance        string                 `json:"balance"`
+	Stickiness     string                 `json:"stickiness"`
+	VrackNetworkID int64                  `json:"vrackNetworkId"`
+	Probe          map[string]interface{} `json:"probe"`
+}
+
+func listIPLBFarm(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_farm.listIPLBFarm", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIPLBType(ctx, d, client, iplbTypes, func(serviceName, iplbType string) error {
+		ids, err := listIPLBIds(client, serviceName, iplbType+"/farm")
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			d.StreamListItem(ctx, IPLBFarm{ServiceName: serviceName, Type: iplbType, ID: id})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_farm.listIPLBFarm", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPLBFarmInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	farm := h.Item.(IPLBFarm)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_farm.getIPLBFarmInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%d", farm.ServiceName, farm.Type, farm.ID), &farm)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_farm.getIPLBFarmInfo", err)
+		return nil, err
+	}
+	return farm, nil
+}
+
+func getIPLBFarm(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = IPLBFarm{
+		ServiceName: d.EqualsQuals["service_name"].GetStringValue(),
+		Type:        d.EqualsQuals["type"].GetStringValue(),
+		ID:          d.EqualsQuals["id"].GetInt64Value(),
+	}
+	return getIPLBFarmInfo(ctx, d, h)
+}
@@ -0,0 +1,203 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPLBFrontend() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_iplb_frontend",
+		Description: "A frontend (HTTP, TCP or UDP) of an IP Load Balancer.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"service_name", "type"}),
+			Hydrate:    listIPLBFrontend,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"service_name", "type", "id"}),
+			Hydrate:      getIPLBFrontend,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPLBFrontendInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the load balancer.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Protocol of the frontend (http, tcp or udp).",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "Frontend ID.",
+			},
+			{
+				Name:        "display_name",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the frontend.",
+			},
+			{
+				Name:        "zone",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Zone of the frontend (all for all the zones).",
+			},
+			{
+				Name:        "port",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Ports listened, comma separated or as ranges (e.g. 80,443 or 8000-8100).",
+			},
+			{
+				Name:        "default_farm_id",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_INT,
+				Description: "ID of the farm receiving the traffic not matched by a route.",
+			},
+			{
+				Name:        "ssl",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SSL"),
+				Description: "True if the frontend terminates SSL (HTTP and TCP frontends).",
+			},
+			{
+				Name:        "default_ssl_id",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("DefaultSSLID").NullIfZero(),
+				Description: "ID of the SSL certificate served when no certificate matches the SNI.",
+			},
+			{
+				Name:        "hsts",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("HSTS"),
+				Description: "True if the HSTS header is sent (HTTP frontends).",
+			},
+			{
+				Name:        "disabled",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Disabled"),
+				Description: "True if the frontend is disabled.",
+			},
+			{
+				Name:        "allowed_source",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "IP blocks allowed to access the frontend, empty when all are allowed.",
+			},
+			{
+				Name:        "denied_source",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "IP blocks denied to access the frontend.",
+			},
+			{
+				Name:        "dedicated_ipfo",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("DedicatedIPFO"),
+				Description: "Failover IPs the frontend is listening on, instead of the IPs of the load balancer.",
+			},
+			{
+				Name:        "redirect_location",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "URL where the requests are redirected (HTTP frontends).",
+			},
+			{
+				Name:        "http_header",
+				Hydrate:     getIPLBFrontendInfo,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("HTTPHeader"),
+				Description: "HTTP headers added to the requests (HTTP frontends).",
+			},
+			titleColumn(getIPLBFrontendInfo, "DisplayName"),
+		},
+	}
+}
+
+type IPLBFrontend struct {
+	ServiceName      string   `json:"-"`
+	Type             string   `json:"-"`
+	ID               int64    `json:"frontendId"`
+	DisplayName      string   `json:"displayName"`
+	Zone             string   `json:"zone"`
+	Port             string   `json:"port"`
+	DefaultFarmID    int64    `json:"defaultFarmId"`
+	SSL              bool     `json:"ssl"`
+	DefaultSSLID     int64    `json:"defaultSslId"`
+	HSTS             bool     `json:"hsts"`
+	Disabled         bool     `json:"disabled"`
+	AllowedSource    []string `json:"allowedSource"`
+	DeniedSource     []string `json:"deniedSource"`
+	DedicatedIPFO    []string `json:"dedicatedIpfo"`
+	RedirectLocation string   `json:"redirectLocation"`
+	HTTPHeader       []string `json:"httpHeader"`
+}
+
+func listIPLBFrontend(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_frontend.listIPLBFrontend", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIPLBType(ctx, d, client, iplbTypes, func(serviceName, iplbType string) error {
+		ids, err := listIPLBIds(client, serviceName, iplbType+"/frontend")
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			d.StreamListItem(ctx, IPLBFrontend{ServiceName: serviceName, Type: iplbType, ID: id})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_frontend.listIPLBFrontend", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPLBFrontendInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	frontend := h.Item.(IPLBFrontend)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_frontend.getIPLBFrontendInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/ipLoadbalancing/%s/%s/frontend/%d", frontend.ServiceName, frontend.Type, frontend.ID), &frontend)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_frontend.getIPLBFrontendInfo", err)
+		return nil, err
+	}
+	return frontend, nil
+}
+
+func getIPLBFrontend(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = IPLBFrontend{
+		ServiceName: d.EqualsQuals["service_name"].GetStringValue(),
+		Type:        d.EqualsQuals["type"].GetStringValue(),
+		ID:          d.EqualsQuals["id"].GetInt64Value(),
+	}
+	return getIPLBFrontendInfo(ctx, d, h)
+}
@@ -0,0 +1,71 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPLBPendingChange() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_iplb_pending_change",
+		Description: "The changes of the configuration of an IP Load Balancer not applied yet, by zone (a refresh is needed).",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"service_name"}),
+			Hydrate:    listIPLBPendingChange,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the load balancer.",
+			},
+			{
+				Name:        "zone",
+				Type:        proto.ColumnType_STRING,
+				Description: "Zone where the changes are pending.",
+			},
+			{
+				Name:        "number",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Number"),
+				Description: "Number of changes pending.",
+			},
+			titleColumn(nil, "Zone"),
+		},
+	}
+}
+
+type IPLBPendingChange struct {
+	ServiceName string `json:"-"`
+	Zone        string `json:"zone"`
+	Number      int    `json:"number"`
+}
+
+func listIPLBPendingChange(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_pending_change.listIPLBPendingChange", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIPLB(ctx, d, client, func(serviceName string) error {
+		var changes []IPLBPendingChange
+		err := client.Get(fmt.Sprintf("/ipLoadbalancing/%s/pendingChanges", serviceName), &changes)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			change.ServiceName = serviceName
+			d.StreamListItem(ctx, change)
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_pending_change.listIPLBPendingChange", err)
+		return nil, err
+	}
+	return nil, nil
+}
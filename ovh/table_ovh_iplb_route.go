@@ -0,0 +1,147 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+)
+
+func tableOvhIPLBRoute() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_iplb_route",
+		Description: "A route of an IP Load Balancer, with its rules and its action.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"service_name", "type"}),
+			Hydrate:    listIPLBRoute,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"service_name", "type", "id"}),
+			Hydrate:      getIPLBRoute,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPLBRouteInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the load balancer.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Protocol of the route (http or tcp).",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "Route ID.",
+			},
+			{
+				Name:        "display_name",
+				Hydrate:     getIPLBRouteInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the route.",
+			},
+			{
+				Name:        "frontend_id",
+				Hydrate:     getIPLBRouteInfo,
+				Type:        proto.ColumnType_INT,
+				Description: "ID of the frontend of the route, null when the route applies to all the frontends.",
+			},
+			{
+				Name:        "weight",
+				Hydrate:     getIPLBRouteInfo,
+				Type:        proto.ColumnType_INT,
+				Description: "Priority of the route, the routes with the lowest weight are evaluated first.",
+			},
+			{
+				Name:        "status",
+				Hydrate:     getIPLBRouteInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the route (ok, creating, updating, deleting...).",
+			},
+			{
+				Name:        "action",
+				Hydrate:     getIPLBRouteInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Action of the route when all its rules match (farm, redirect or reject), with its target.",
+			},
+			{
+				Name:        "rules",
+				Hydrate:     getIPLBRouteInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Rules of the route: field, match, pattern and negate.",
+			},
+			titleColumn(getIPLBRouteInfo, "DisplayName"),
+		},
+	}
+}
+
+type IPLBRoute struct {
+	ServiceName string                   `json:"-"`
+	Type        string                   `json:"-"`
+	ID          int64                    `json:"routeId"`
+	DisplayName string                   `json:"displayName"`
+	FrontendID  int64                    `json:"frontendId"`
+	Weight      int                      `json:"weight"`
+	Status      string                   `json:"status"`
+	Action      map[string]interface{}   `json:"action"`
+	Rules       []map[string]interface{} `json:"rules"`
+}
+
+func listIPLBRoute(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_route.listIPLBRoute", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIPLBType(ctx, d, client, iplbRouteTypes, func(serviceName, iplbType string) error {
+		ids, err := listIPLBIds(client, serviceName, iplbType+"/route")
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			d.StreamListItem(ctx, IPLBRoute{ServiceName: serviceName, Type: iplbType, ID: id})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_route.listIPLBRoute", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPLBRouteInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	route := h.Item.(IPLBRoute)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_route.getIPLBRouteInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/ipLoadbalancing/%s/%s/route/%d", route.ServiceName, route.Type, route.ID), &route)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_route.getIPLBRouteInfo", err)
+		return nil, err
+	}
+	return route, nil
+}
+
+func getIPLBRoute(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = IPLBRoute{
+		ServiceName: d.EqualsQuals["service_name"].GetStringValue(),
+		Type:        d.EqualsQuals["type"].GetStringValue(),
+		ID:          d.EqualsQuals["id"].GetInt64Value(),
+	}
+	return getIPLBRouteInfo(ctx, d, h)
+}
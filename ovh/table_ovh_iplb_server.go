@@ -0,0 +1,198 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPLBServer() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_iplb_server",
+		Description: "A server of a farm of an IP Load Balancer.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"service_name", "type", "farm_id"}),
+			Hydrate:    listIPLBServer,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"service_name", "type", "farm_id", "id"}),
+			Hydrate:      getIPLBServer,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPLBServerInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the load balancer.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Protocol of the farm (http, tcp or udp).",
+			},
+			{
+				Name:        "farm_id",
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("FarmID"),
+				Description: "ID of the farm of the server.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "Server ID.",
+			},
+			{
+				Name:        "display_name",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the server.",
+			},
+			{
+				Name:        "address",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_INET,
+				Description: "IP of the server.",
+			},
+			{
+				Name:        "port",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_INT,
+				Description: "Port of the server, null when the port of the farm is used.",
+			},
+			{
+				Name:        "status",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Status of the server in the farm (active or inactive).",
+			},
+			{
+				Name:        "weight",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_INT,
+				Transform:   transform.FromField("Weight"),
+				Description: "Weight of the server in the farm.",
+			},
+			{
+				Name:        "backup",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Backup"),
+				Description: "True if the server receives the traffic only when the other servers are down.",
+			},
+			{
+				Name:        "probe",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("Probe"),
+				Description: "True if the server is checked by the probe of the farm.",
+			},
+			{
+				Name:        "ssl",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_BOOL,
+				Transform:   transform.FromField("SSL"),
+				Description: "True if the traffic to the server is encrypted.",
+			},
+			{
+				Name:        "proxy_protocol_version",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Version of the PROXY protocol sent to the server, null when disabled.",
+			},
+			{
+				Name:        "server_state",
+				Hydrate:     getIPLBServerInfo,
+				Type:        proto.ColumnType_JSON,
+				Description: "Health of the server in each zone, as seen by the probe.",
+			},
+			titleColumn(getIPLBServerInfo, "DisplayName"),
+		},
+	}
+}
+
+type IPLBServer struct {
+	ServiceName          string                   `json:"-"`
+	Type                 string                   `json:"-"`
+	FarmID               int64                    `json:"-"`
+	ID                   int64                    `json:"serverId"`
+	DisplayName          string                   `json:"displayName"`
+	Address              string                   `json:"address"`
+	Port                 int                      `json:"port"`
+	Status               string                   `json:"status"`
+	Weight               int                      `json:"weight"`
+	Backup               bool                     `json:"backup"`
+	Probe                bool                     `json:"probe"`
+	SSL                  bool                     `json:"ssl"`
+	ProxyProtocolVersion string                   `json:"proxyProtocolVersion"`
+	ServerState          []map[string]interface{} `json:"serverState"`
+}
+
+func listIPLBServer(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_server.listIPLBServer", "connection_error", err)
+		return nil, err
+	}
+	qualFarmId := d.EqualsQuals["farm_id"].GetInt64Value()
+	err = forEachIPLBType(ctx, d, client, iplbTypes, func(serviceName, iplbType string) error {
+		farmIds, err := listIPLBIds(client, serviceName, iplbType+"/farm")
+		if err != nil {
+			return err
+		}
+		for _, farmId := range farmIds {
+			if qualFarmId != 0 && farmId != qualFarmId {
+				continue
+			}
+			ids, err := listIPLBIds(client, serviceName, fmt.Sprintf("%s/farm/%d/server", iplbType, farmId))
+			if err != nil {
+				return err
+			}
+			for _, id := range ids {
+				d.StreamListItem(ctx, IPLBServer{ServiceName: serviceName, Type: iplbType, FarmID: farmId, ID: id})
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_server.listIPLBServer", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPLBServerInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	server := h.Item.(IPLBServer)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_server.getIPLBServerInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/ipLoadbalancing/%s/%s/farm/%d/server/%d", server.ServiceName, server.Type, server.FarmID, server.ID), &server)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_server.getIPLBServerInfo", err)
+		return nil, err
+	}
+	return server, nil
+}
+
+func getIPLBServer(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = IPLBServer{
+		ServiceName: d.EqualsQuals["service_name"].GetStringValue(),
+		Type:        d.EqualsQuals["type"].GetStringValue(),
+		FarmID:      d.EqualsQuals["farm_id"].GetInt64Value(),
+		ID:          d.EqualsQuals["id"].GetInt64Value(),
+	}
+	return getIPLBServerInfo(ctx, d, h)
+}
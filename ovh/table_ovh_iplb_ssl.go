@@ -0,0 +1,150 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhIPLBSSL() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_iplb_ssl",
+		Description: "An SSL certificate of an IP Load Balancer.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"service_name"}),
+			Hydrate:    listIPLBSSL,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.AllColumns([]string{"service_name", "id"}),
+			Hydrate:      getIPLBSSL,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getIPLBSSLInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the load balancer.",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_INT,
+				Description: "Certificate ID.",
+			},
+			{
+				Name:        "display_name",
+				Hydrate:     getIPLBSSLInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the certificate.",
+			},
+			{
+				Name:        "type",
+				Hydrate:     getIPLBSSLInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the certificate: built (Let's Encrypt), built_not_routed or custom.",
+			},
+			{
+				Name:        "subject",
+				Hydrate:     getIPLBSSLInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Subject of the certificate.",
+			},
+			{
+				Name:        "san",
+				Hydrate:     getIPLBSSLInfo,
+				Type:        proto.ColumnType_JSON,
+				Transform:   transform.FromField("SAN"),
+				Description: "Subject alternative names of the certificate.",
+			},
+			{
+				Name:        "fingerprint",
+				Hydrate:     getIPLBSSLInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Fingerprint of the certificate.",
+			},
+			{
+				Name:        "serial",
+				Hydrate:     getIPLBSSLInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Serial number of the certificate.",
+			},
+			{
+				Name:        "expire_date",
+				Hydrate:     getIPLBSSLInfo,
+				Type:        proto.ColumnType_TIMESTAMP,
+				Description: "Expiration date of the certificate.",
+			},
+			titleColumn(getIPLBSSLInfo, "DisplayName"),
+		},
+	}
+}
+
+type IPLBSSL struct {
+	ServiceName string     `json:"-"`
+	ID          int64      `json:"id"`
+	DisplayName string     `json:"displayName"`
+	Type        string     `json:"type"`
+	Subject     string     `json:"subject"`
+	SAN         []string   `json:"san"`
+	Fingerprint string     `json:"fingerprint"`
+	Serial      string     `json:"serial"`
+	ExpireDate  *time.Time `json:"expireDate"`
+}
+
+func listIPLBSSL(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_ssl.listIPLBSSL", "connection_error", err)
+		return nil, err
+	}
+	err = forEachIPLB(ctx, d, client, func(serviceName string) error {
+		ids, err := listIPLBIds(client, serviceName, "ssl")
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			d.StreamListItem(ctx, IPLBSSL{ServiceName: serviceName, ID: id})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_ssl.listIPLBSSL", err)
+		return nil, err
+	}
+	return nil, nil
+}
+
+func getIPLBSSLInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	ssl := h.Item.(IPLBSSL)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_ssl.getIPLBSSLInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/ipLoadbalancing/%s/ssl/%d", ssl.ServiceName, ssl.ID), &ssl)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_iplb_ssl.getIPLBSSLInfo", err)
+		return nil, err
+	}
+	return ssl, nil
+}
+
+func getIPLBSSL(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = IPLBSSL{
+		ServiceName: d.EqualsQuals["service_name"].GetStringValue(),
+		ID:          d.EqualsQuals["id"].GetInt64Value(),
+	}
+	return getIPLBSSLInfo(ctx, d, h)
+}
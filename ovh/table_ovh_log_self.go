@@ -2,10 +2,14 @@ package ovh
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strconv"
+	"sync"
 	"time"
 
+	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
 )
@@ -21,18 +25,42 @@ type Log struct {
 }
 
 func tableOvhLog() *plugin.Table {
+	return logTable("ovh_log_self", "Logs of your account.")
+}
+
+func tableOvhLogService() *plugin.Table {
+	return logTable("ovh_log_service", "Logs of the calls to the API made on the services of your account, by its users and applications.")
+}
+
+// Paths of the logs of each table.
+var logPaths = map[string]string{
+	"ovh_log_self":    "/me/api/logs/self",
+	"ovh_log_service": "/me/api/logs/services",
+}
+
+func logTable(name, description string) *plugin.Table {
 	return &plugin.Table{
-		Name:        "ovh_log_self",
-		Description: "Logs of your account.",
+		Name:        name,
+		Description: description,
 		List: &plugin.ListConfig{
 			Hydrate: listLog,
+			KeyColumns: plugin.KeyColumnSlice{
+				dateRangeKeyColumn("date"),
+				{Name: "account", Require: plugin.Optional},
+				{Name: "method", Require: plugin.Optional},
+			},
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"id"}),
-			Hydrate:    getLog,
+			KeyColumns:   plugin.AllColumns([]string{"id"}),
+			Hydrate:      getLog,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getLogInfo},
+			{
+				Func:           getLogInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -76,54 +104,128 @@ func tableOvhLog() *plugin.Table {
 				Type:        proto.ColumnType_STRING,
 				Description: "Path used for the action with project and object IDs.",
 			},
+			titleColumn(getLogInfo, "Path"),
 		},
 	}
 }
 
 func getLogInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	log := h.Item.(Log)
+	// The details of the logs filtered by the list are already fetched
+	if !log.Date.IsZero() {
+		return log, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_log_self.getLogInfo", "connection_error", err)
+		plugin.Logger(ctx).Error(d.Table.Name+".getLogInfo", "connection_error", err)
 		return nil, err
 	}
 
-	err = client.Get(fmt.Sprintf("/me/api/logs/self/%d", log.ID), &log)
+	err = client.Get(fmt.Sprintf("%s/%d", logPaths[d.Table.Name], log.ID), &log)
 
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_log_self.getLogInfo", err)
+		plugin.Logger(ctx).Error(d.Table.Name+".getLogInfo", err)
 		return nil, err
 	}
 
 	return log, nil
 }
 
+// listLog lists the logs from the newest to the oldest, their IDs increasing
+// with their date, to stop at the limit of the query. The API does not
+// filter the logs: when the query filters on their date, account or method,
+// the details of the logs are fetched by batches and the logs are filtered
+// here, stopping at the first log older than the range of dates.
 func listLog(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_log_self.listLog", "connection_error", err)
+		plugin.Logger(ctx).Error(d.Table.Name+".listLog", "connection_error", err)
 		return nil, err
 	}
 
+	path := logPaths[d.Table.Name]
 	var logsId []int
-	err = client.Get("/me/api/logs/self", &logsId)
+	err = client.Get(path, &logsId)
 
 	if err != nil {
-		plugin.Logger(ctx).Error("ovh_log_self.listLog", err)
+		plugin.Logger(ctx).Error(d.Table.Name+".listLog", err)
 		return nil, err
 	}
+	sort.Sort(sort.Reverse(sort.IntSlice(logsId)))
 
-	for _, logId := range logsId {
-		var log Log
-		log.ID = logId
-		d.StreamListItem(ctx, log)
+	from, to := dateRange(d, "date")
+	account := d.EqualsQuals["account"].GetStringValue()
+	method := d.EqualsQuals["method"].GetStringValue()
+	if from.IsZero() && to.IsZero() && account == "" && method == "" {
+		for _, logId := range logsId {
+			d.StreamListItem(ctx, Log{ID: logId})
+			if rowsRemaining(ctx, d) == 0 {
+				return nil, nil
+			}
+		}
+		return nil, nil
+	}
+
+	for start := 0; start < len(logsId); start += hydrateConcurrency {
+		logs, err := getLogs(ctx, client, path, logsId[start:min(start+hydrateConcurrency, len(logsId))])
+		if err != nil {
+			plugin.Logger(ctx).Error(d.Table.Name+".listLog", err)
+			return nil, err
+		}
+		for _, log := range logs {
+			if log.Date.IsZero() {
+				continue
+			}
+			if !from.IsZero() && log.Date.Before(from) {
+				return nil, nil
+			}
+			if (!to.IsZero() && log.Date.After(to)) || (account != "" && log.Account != account) || (method != "" && log.Method != method) {
+				continue
+			}
+			d.StreamListItem(ctx, log)
+			if rowsRemaining(ctx, d) == 0 {
+				return nil, nil
+			}
+		}
 	}
 
 	return nil, nil
 }
 
-func getLog(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+// getLogs fetches the details of logs with hydrateConcurrency requests at
+// most at once, the logs which no longer exist being left empty. Each worker
+// has its own copy of client, whose requests wait for the rate limiters of
+// the query.
+func getLogs(ctx context.Context, client *ovh.Client, path string, logsId []int) ([]Log, error) {
+	logs := make([]Log, len(logsId))
+	errs := make([]error, len(logsId))
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for range min(hydrateConcurrency, len(logsId)) {
+		wg.Add(1)
+		go func(client *ovh.Client) {
+			defer wg.Done()
+			for i := range indexes {
+				var log Log
+				err := client.GetWithContext(ctx, fmt.Sprintf("%s/%d", path, logsId[i]), &log)
+				if err != nil && !ShouldIgnoreError(ctx, nil, nil, err) {
+					errs[i] = err
+					continue
+				}
+				logs[i] = log
+			}
+		}(copyClient(client))
+	}
+	for i := range logsId {
+		indexes <- i
+	}
+	close(indexes)
+	wg.Wait()
+	return logs, errors.Join(errs...)
+}
+
+func getLog(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	strId := d.EqualsQuals["id"].GetStringValue()
 	var log Log
 	intId, err := strconv.Atoi(strId)
@@ -131,5 +233,6 @@ func getLog(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (in
 		return nil, err
 	}
 	log.ID = intId
-	return log, nil
+	h.Item = log
+	return getLogInfo(ctx, d, h)
 }
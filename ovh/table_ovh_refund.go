@@ -28,14 +28,20 @@ func tableOvhRefund() *plugin.Table {
 		Name:        "ovh_refund",
 		Description: "Refunds of your account.",
 		List: &plugin.ListConfig{
-			Hydrate: listRefund,
+			Hydrate:    listRefund,
+			KeyColumns: plugin.KeyColumnSlice{dateRangeKeyColumn("date")},
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"id"}),
-			Hydrate:    getRefund,
+			KeyColumns:   plugin.AllColumns([]string{"id"}),
+			Hydrate:      getRefund,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
 		},
 		HydrateConfig: []plugin.HydrateConfig{
-			{Func: getRefundInfo},
+			{
+				Func:           getRefundInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -104,12 +110,17 @@ func tableOvhRefund() *plugin.Table {
 				Transform:   transform.FromField("Tax.Value"),
 				Description: "Amount of the tax.",
 			},
+			titleColumn(nil, "ID"),
 		},
 	}
 }
 
 func getRefundInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	refund := h.Item.(Refund)
+	// The get call already fetched the details
+	if !refund.Date.IsZero() {
+		return refund, nil
+	}
 
 	client, err := connect(ctx, d)
 	if err != nil {
@@ -128,14 +139,14 @@ func getRefundInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateDa
 }
 
 func listRefund(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_refund.listRefund", "connection_error", err)
 		return nil, err
 	}
 
 	var refundsId []string
-	err = client.Get("/me/refund", &refundsId)
+	err = client.Get("/me/refund"+dateRangeQuery(d, "date", "date"), &refundsId)
 
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_refund.listRefund", err)
@@ -151,9 +162,10 @@ func listRefund(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData)
 	return nil, nil
 }
 
-func getRefund(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+func getRefund(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
 	id := d.Quals.ToEqualsQualValueMap()["id"].GetStringValue()
 	var refund Refund
 	refund.ID = id
-	return refund, nil
+	h.Item = refund
+	return getRefundInfo(ctx, d, h)
 }
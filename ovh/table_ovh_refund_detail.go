@@ -32,8 +32,16 @@ func tableOvhRefundDetails() *plugin.Table {
 			Hydrate:    listRefundDetails,
 		},
 		Get: &plugin.GetConfig{
-			KeyColumns: plugin.AllColumns([]string{"refund_id", "id"}),
-			Hydrate:    getRefundDetail,
+			KeyColumns:   plugin.AllColumns([]string{"refund_id", "id"}),
+			Hydrate:      getRefundDetail,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getGetRefundDetailInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
 		},
 		Columns: []*plugin.Column{
 			{
@@ -79,6 +87,7 @@ func tableOvhRefundDetails() *plugin.Table {
 				Transform:   transform.FromField("UnitPrice.Value"),
 				Description: "Unit price of this detail.",
 			},
+			titleColumn(getGetRefundDetailInfo, "Description"),
 		},
 	}
 }
@@ -105,7 +114,7 @@ func getGetRefundDetailInfo(ctx context.Context, d *plugin.QueryData, h *plugin.
 }
 
 func listRefundDetails(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
-	client, err := connect(ctx, d)
+	client, err := connectList(ctx, d)
 	if err != nil {
 		plugin.Logger(ctx).Error("ovh_refund_detail.listRefundDetails", "connection_error", err)
 		return nil, err
@@ -0,0 +1,151 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/ovh/go-ovh/ovh"
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+)
+
+func tableOvhVrack() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_vrack",
+		Description: "A vRack, the private network between the services of the account.",
+		List: &plugin.ListConfig{
+			KeyColumns: iamTagsKeyColumns(),
+			Hydrate:    listVrack,
+		},
+		Get: &plugin.GetConfig{
+			KeyColumns:   plugin.SingleColumn("service_name"),
+			Hydrate:      getVrack,
+			IgnoreConfig: &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+		},
+		HydrateConfig: []plugin.HydrateConfig{
+			{
+				Func:           getVrackInfo,
+				MaxConcurrency: hydrateConcurrency,
+				IgnoreConfig:   &plugin.IgnoreConfig{ShouldIgnoreErrorFunc: ShouldIgnoreError},
+			},
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the vRack (e.g. pn-123456).",
+			},
+			{
+				Name:        "name",
+				Hydrate:     getVrackInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Name of the vRack.",
+			},
+			{
+				Name:        "description",
+				Hydrate:     getVrackInfo,
+				Type:        proto.ColumnType_STRING,
+				Description: "Description of the vRack.",
+			},
+			titleColumn(getVrackInfo, "Iam.DisplayName"),
+			akasColumn(getVrackInfo, "Iam.URN"),
+			tagsColumn(getVrackInfo, "Iam.Tags"),
+		},
+	}
+}
+
+type Vrack struct {
+	ServiceName string `json:"-"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Iam         Iam    `json:"iam"`
+}
+
+func listVrack(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_vrack.listVrack", "connection_error", err)
+		return nil, err
+	}
+
+	var serviceNames []string
+	err = client.Get("/vrack"+iamTagsQuery(d, "iamTags"), &serviceNames)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_vrack.listVrack", err)
+		return nil, err
+	}
+
+	for _, serviceName := range serviceNames {
+		d.StreamListItem(ctx, Vrack{ServiceName: serviceName})
+	}
+	return nil, nil
+}
+
+func getVrackInfo(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	vrack := h.Item.(Vrack)
+
+	client, err := connect(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_vrack.getVrackInfo", "connection_error", err)
+		return nil, err
+	}
+
+	err = client.Get(fmt.Sprintf("/vrack/%s", vrack.ServiceName), &vrack)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_vrack.getVrackInfo", err)
+		return nil, err
+	}
+	return vrack, nil
+}
+
+func getVrack(ctx context.Context, d *plugin.QueryData, h *plugin.HydrateData) (interface{}, error) {
+	h.Item = Vrack{ServiceName: d.EqualsQuals["service_name"].GetStringValue()}
+	return getVrackInfo(ctx, d, h)
+}
+
+// Types of the services attached to a vRack, each one being a sub-resource
+// of /vrack/{serviceName}.
+var vrackMemberTypes = []string{"cloudProject", "dedicatedCloud", "dedicatedServer", "ip", "ipLoadbalancing"}
+
+// forEachVrack calls listFunc for each vRack of the account, or only for the
+// one given in the service_name qual. The vRacks removed or expired while being
+// listed are skipped.
+func forEachVrack(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(serviceName string) error) error {
+	var serviceNames []string
+	if serviceName := d.EqualsQuals["service_name"].GetStringValue(); serviceName != "" {
+		serviceNames = []string{serviceName}
+	} else if err := client.Get("/vrack", &serviceNames); err != nil {
+		return err
+	}
+	for _, serviceName := range serviceNames {
+		if ctx.Err() != nil || rowsRemaining(ctx, d) == 0 {
+			break
+		}
+		if err := listFunc(serviceName); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+			return err
+		}
+	}
+	return nil
+}
+
+// forEachVrackMemberType calls listFunc for each vRack of forEachVrack and
+// each type of member, or only for the type given in the type qual. The types
+// a vRack does not have (404) are skipped.
+func forEachVrackMemberType(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(serviceName, memberType string) error) error {
+	memberTypes := vrackMemberTypes
+	if memberType := d.EqualsQuals["type"].GetStringValue(); memberType != "" {
+		if !slices.Contains(memberTypes, memberType) {
+			return nil
+		}
+		memberTypes = []string{memberType}
+	}
+	return forEachVrack(ctx, d, client, func(serviceName string) error {
+		for _, memberType := range memberTypes {
+			if err := listFunc(serviceName, memberType); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+				return err
+			}
+		}
+		return nil
+	})
+}
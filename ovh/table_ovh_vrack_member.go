@@ -0,0 +1,70 @@
+package ovh
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/turbot/steampipe-plugin-sdk/v6/grpc/proto"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"github.com/turbot/steampipe-plugin-sdk/v6/plugin/transform"
+)
+
+func tableOvhVrackMember() *plugin.Table {
+	return &plugin.Table{
+		Name:        "ovh_vrack_member",
+		Description: "A service attached to a vRack.",
+		List: &plugin.ListConfig{
+			KeyColumns: plugin.OptionalColumns([]string{"service_name", "type"}),
+			Hydrate:    listVrackMember,
+		},
+		Columns: []*plugin.Column{
+			{
+				Name:        "service_name",
+				Type:        proto.ColumnType_STRING,
+				Description: "Service name of the vRack.",
+			},
+			{
+				Name:        "type",
+				Type:        proto.ColumnType_STRING,
+				Description: "Type of the service (cloudProject, dedicatedCloud, dedicatedServer, ip, ipLoadbalancing).",
+			},
+			{
+				Name:        "id",
+				Type:        proto.ColumnType_STRING,
+				Transform:   transform.FromField("ID"),
+				Description: "ID of the service: the ID of the cloud project, the name of the dedicated server or dedicated cloud, the IP block or the service name of the load balancer.",
+			},
+			titleColumn(nil, "ID"),
+		},
+	}
+}
+
+type VrackMember struct {
+	ServiceName string
+	Type        string
+	ID          string
+}
+
+func listVrackMember(ctx context.Context, d *plugin.QueryData, _ *plugin.HydrateData) (interface{}, error) {
+	client, err := connectList(ctx, d)
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_vrack_member.listVrackMember", "connection_error", err)
+		return nil, err
+	}
+	err = forEachVrackMemberType(ctx, d, client, func(serviceName, memberType string) error {
+		var ids []string
+		err := client.Get(fmt.Sprintf("/vrack/%s/%s", serviceName, memberType), &ids)
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			d.StreamListItem(ctx, VrackMember{ServiceName: serviceName, Type: memberType, ID: id})
+		}
+		return nil
+	})
+	if err != nil {
+		plugin.Logger(ctx).Error("ovh_vrack_member.listVrackMember", err)
+		return nil, err
+	}
+	return nil, nil
+}
@@ -0,0 +1,1449 @@
+package ovh
+
+import (
+	"net/http"
+	"net/url"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+)
+
+// Projects of the fixtures.
+const (
+	testProject1 = "5f4d3c2b1a0987654321fedcba987654"
+	testProject2 = "9e8d7c6b5a4f3e2d1c0b9a8f7e6d5c4b"
+)
+
+type row = map[string]interface{}
+
+type testQuals = map[string]interface{}
+
+func TestListTables(t *testing.T) {
+	tests := []struct {
+		table string
+		quals testQuals
+		key   string
+		rows  []row
+	}{
+		{
+			table: "ovh_cloud_project",
+			key:   "id",
+			rows: []row{
+				{"id": testProject1, "name": "production", "description": "Production project", "status": "ok", "created_at": "2023-03-01T10:00:00Z", "title": "production", "akas": []string{"urn:v1:eu:resource:publicCloudProject:" + testProject1}, "tags": map[string]string{"environment": "production"}},
+				{"id": testProject2, "name": "staging", "description": "Staging project", "status": "ok"},
+			},
+		},
+		{
+			table: "ovh_cloud_instance",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11", "name": "web-1", "flavor_id": "b2-7", "region": "GRA11", "status": "ACTIVE", "created_at": "2024-01-15T08:30:00Z", "current_month_outgoing_traffic": 1024, "ip_addresses": []row{{"ip": "198.51.100.5", "type": "public", "version": 4, "networkId": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", "gatewayIp": "198.51.100.1"}, {"ip": "10.0.0.12", "type": "private", "version": 4, "networkId": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d", "gatewayIp": ""}}, "title": "web-1"},
+				{"project_id": testProject2, "id": "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f", "name": "db-1", "flavor_id": "b2-15", "region": "SBG5", "status": "SHUTOFF", "current_month_outgoing_traffic": nil, "ip_addresses": nil},
+			},
+		},
+		{
+			table: "ovh_cloud_instance",
+			quals: testQuals{"project_id": testProject2},
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject2, "id": "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f", "name": "db-1"},
+			},
+		},
+		{
+			table: "ovh_cloud_instance_interface",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "instance_id": "f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11", "id": "0d1e2f3a-4b5c-4d6e-9f7a-8b9c0d1e2f3a", "type": "public", "network_id": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", "mac_address": "fa:16:3e:00:00:01", "state": "ACTIVE", "fixed_ips": []row{{"ip": "198.51.100.5", "subnetId": "6f7a8b9c-0d1e-4f2a-9b3c-4d5e6f7a8b9c"}}},
+				{"project_id": testProject1, "instance_id": "f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11", "id": "1e2f3a4b-5c6d-4e7f-8a9b-0c1d2e3f4a5b", "type": "private", "network_id": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"},
+			},
+		},
+		{
+			table: "ovh_cloud_instance_interface",
+			quals: testQuals{"instance_id": "0c1d2e3f-4a5b-4c6d-8e7f-9a0b1c2d3e4f"},
+			rows:  []row{},
+		},
+		{
+			table: "ovh_cloud_network_private",
+			key:   "id",
+			rows: []row{
+				// VLAN 0 is the untagged VLAN of the vRack
+				{"project_id": testProject1, "id": "pn-123456_0", "name": "backend", "vlan_id": 0, "status": "ACTIVE", "regions": []row{{"region": "GRA11", "status": "ACTIVE", "openstackId": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d"}}},
+				{"project_id": testProject1, "id": "pn-123456_42", "name": "admin", "vlan_id": 42},
+			},
+		},
+		{
+			table: "ovh_cloud_network_subnet",
+			quals: testQuals{"network_id": "pn-123456_42"},
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "network_id": "pn-123456_42", "id": "4d5e6f7a-8b9c-4d0e-9f1a-2b3c4d5e6f7a", "cidr": "192.168.42.0/24", "gateway_ip": nil, "ip_pools": []row{{"region": "GRA11", "network": "192.168.42.0/24", "start": "192.168.42.10", "end": "192.168.42.200", "dhcp": false}}},
+			},
+		},
+		{
+			table: "ovh_cloud_network_subnet",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "network_id": "pn-123456_0", "id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "cidr": "10.0.0.0/24", "gateway_ip": "10.0.0.1", "title": "10.0.0.0/24"},
+				{"project_id": testProject1, "network_id": "pn-123456_42", "id": "4d5e6f7a-8b9c-4d0e-9f1a-2b3c4d5e6f7a"},
+			},
+		},
+		{
+			table: "ovh_cloud_network_public",
+			key:   "project_id",
+			rows: []row{
+				{"project_id": testProject1, "id": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", "name": "Ext-Net", "type": "public"},
+				{"project_id": testProject2, "id": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", "regions": []row{{"region": "SBG5", "status": "ACTIVE", "openstackId": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e"}}},
+			},
+		},
+		{
+			// The second project has no gateway in its region (404)
+			table: "ovh_cloud_gateway",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "region": "GRA11", "id": "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b", "name": "backend-gateway", "model": "s", "status": "active", "external_information": row{"networkId": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6e", "ips": []row{{"ip": "203.0.113.10", "subnetId": "6f7a8b9c-0d1e-4f2a-9b3c-4d5e6f7a8b9c"}}}, "interfaces": []row{{"id": "7a8b9c0d-1e2f-4a3b-8c4d-5e6f7a8b9c0d", "ip": "10.0.0.1", "networkId": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d", "subnetId": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"}}},
+			},
+		},
+		{
+			table: "ovh_cloud_gateway",
+			quals: testQuals{"region": "SBG5"},
+			rows:  []row{},
+		},
+		{
+			table: "ovh_cloud_floating_ip",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "region": "GRA11", "id": "8b9c0d1e-2f3a-4b4c-9d5e-6f7a8b9c0d1e", "ip": "203.0.113.20", "status": "active", "associated_entity": row{"id": "9c0d1e2f-3a4b-4c5d-8e6f-7a8b9c0d1e2f", "type": "instance", "ip": "10.0.0.12", "gatewayId": "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"}, "title": "203.0.113.20"},
+				{"project_id": testProject1, "id": "9c0d1e2f-3a4b-4c5d-8e6f-7a8b9c0d1e3a", "status": "down", "associated_entity": nil},
+			},
+		},
+		{
+			// The second project has no load balancer in its region (404)
+			table: "ovh_cloud_loadbalancer",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "region": "GRA11", "id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", "name": "web", "operating_status": "online", "vip_address": "10.0.0.50", "vip_network_id": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d", "floating_ip": row{"id": "8b9c0d1e-2f3a-4b4c-9d5e-6f7a8b9c0d1e", "ip": "203.0.113.20"}, "created_at": "2024-03-01T09:00:00Z", "title": "web"},
+			},
+		},
+		{
+			table: "ovh_cloud_loadbalancer_listener",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "region": "GRA11", "loadbalancer_id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", "id": "b1c2d3e4-f5a6-4b7c-8d9e-0f1a2b3c4d5e", "protocol": "http", "port": 80, "default_pool_id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f", "allowed_cidrs": []string{}, "secret_id": nil, "tls_versions": nil},
+				{"project_id": testProject1, "id": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6f", "protocol": "terminated-https", "port": 443, "allowed_cidrs": []string{"192.0.2.0/24"}, "secret_id": "e1f2a3b4-c5d6-4e7f-8a9b-0c1d2e3f4a5b", "tls_versions": []string{"TLSv1.2", "TLSv1.3"}},
+			},
+		},
+		{
+			table: "ovh_cloud_loadbalancer_pool",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "region": "GRA11", "loadbalancer_id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d", "id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f", "name": "web-servers", "algorithm": "roundRobin", "operating_status": "degraded", "session_persistence": row{"type": "httpCookie", "cookieName": "SERVERID"}},
+				{"project_id": testProject1, "id": "d2e3f4a5-b6c7-4d8e-9f0a-1b2c3d4e5f6a", "protocol": "tcp", "session_persistence": nil},
+			},
+		},
+		{
+			table: "ovh_cloud_loadbalancer_member",
+			quals: testQuals{"pool_id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f"},
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "region": "GRA11", "pool_id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f", "id": "f1a2b3c4-d5e6-4f7a-8b9c-0d1e2f3a4b5c", "name": "web-1", "address": "10.0.0.12", "protocol_port": 8080, "weight": 1},
+				// A weight of 0 drains the member
+				{"project_id": testProject1, "id": "f2a3b4c5-d6e7-4a8b-9c0d-1e2f3a4b5c6d", "weight": 0, "operating_status": "error"},
+			},
+		},
+		{
+			table: "ovh_cloud_loadbalancer_member",
+			quals: testQuals{"pool_id": "d2e3f4a5-b6c7-4d8e-9f0a-1b2c3d4e5f6a"},
+			rows:  []row{},
+		},
+		{
+			table: "ovh_cloud_kube_cluster",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "name": "production", "version": "1.29", "next_upgrade_versions": []string{"1.30"}, "update_policy": "MINIMAL_DOWNTIME", "is_up_to_date": false, "control_plane_is_up_to_date": true, "url": "abc123.c1.gra11.k8s.ovh.net", "private_network_configuration": row{"defaultVrackGateway": "10.0.0.1", "privateNetworkRoutingAsDefault": true}, "customization": row{"apiServer": row{"admissionPlugins": row{"enabled": []string{"NodeRestriction"}, "disabled": []string{"AlwaysPullImages"}}}}, "open_id_connect": row{"clientId": "kubernetes", "issuerUrl": "https://sso.example.com/realms/ops", "groupsClaim": []string{"groups"}, "usernameClaim": "email"}, "ip_restrictions": []string{"192.0.2.0/24"}},
+				// OIDC is not configured
+				{"project_id": testProject2, "id": "8b9c0d1e-2f3a-4b5c-9d6e-7f8a9b0c1d2e", "name": "staging", "kube_proxy_mode": "ipvs", "private_network_id": nil, "private_network_configuration": nil, "open_id_connect": nil, "ip_restrictions": []string{}},
+			},
+		},
+		{
+			table: "ovh_cloud_kube_node_pool",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "id": "d4e5f6a7-b8c9-4d0e-8f1a-2b3c4d5e6f7a", "name": "default", "flavor": "b2-7", "autoscale": false, "anti_affinity": true, "desired_nodes": 2, "max_nodes": 5, "up_to_date_nodes": 1, "template": row{"metadata": row{"labels": row{"role": "web"}, "annotations": row{}, "finalizers": []string{}}, "spec": row{"unschedulable": false, "taints": []string{}}}},
+			},
+		},
+		{
+			table: "ovh_cloud_kube_node",
+			quals: testQuals{"kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"},
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "id": "e5f6a7b8-c9d0-4e1f-9a2b-3c4d5e6f7a8b", "name": "default-node-1", "node_pool_id": "d4e5f6a7-b8c9-4d0e-8f1a-2b3c4d5e6f7a", "is_up_to_date": true, "version": "1.29.4"},
+				{"project_id": testProject1, "id": "f6a7b8c9-d0e1-4f2a-8b3c-4d5e6f7a8b9c", "is_up_to_date": false, "version": "1.28.9"},
+			},
+		},
+		{
+			table: "ovh_cloud_registry",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "a7b8c9d0-e1f2-4a3b-8c4d-5e6f7a8b9c0d", "name": "apps", "region": "GRA", "url": "https://abc123.gra7.container-registry.ovh.net", "version": "2.10.2", "size": 1073741824, "size_limit": 214748364800, "iam_enabled": false, "open_id_connect": row{"name": "sso", "endpoint": "https://sso.example.com/realms/ops", "clientId": "harbor", "scope": "openid,profile,email", "groupsClaim": "groups", "adminGroup": "ops", "verifyCert": true}},
+				// OIDC is not configured
+				{"project_id": testProject1, "id": "b8c9d0e1-f2a3-4b4c-9d5e-6f7a8b9c0d1e", "name": "sandbox", "iam_enabled": true, "open_id_connect": nil},
+			},
+		},
+		{
+			table: "ovh_cloud_registry_user",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "registry_id": "a7b8c9d0-e1f2-4a3b-8c4d-5e6f7a8b9c0d", "id": 3, "user": "ci", "email": "ci@example.com", "title": "ci"},
+				{"project_id": testProject1, "registry_id": "a7b8c9d0-e1f2-4a3b-8c4d-5e6f7a8b9c0d", "id": 4, "user": "alice"},
+			},
+		},
+		{
+			table: "ovh_cloud_registry_ip_restriction",
+			key:   "ip_block",
+			rows: []row{
+				{"project_id": testProject1, "registry_id": "a7b8c9d0-e1f2-4a3b-8c4d-5e6f7a8b9c0d", "type": "management", "ip_block": "192.0.2.0/24", "description": "Office"},
+			},
+		},
+		{
+			table: "ovh_cloud_registry_ip_restriction",
+			quals: testQuals{"type": "unknown"},
+			rows:  []row{},
+		},
+		{
+			table: "ovh_cloud_volume",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "7e3f1b2a-9c8d-4e5f-a6b7-c8d9e0f1a2b3", "name": "data", "attached_to": []string{"f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11"}, "size": 100, "status": "in-use", "type": "classic", "title": "data"},
+			},
+		},
+		{
+			table: "ovh_cloud_volume_snapshot",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "3b2a1c0d-e9f8-4a7b-b6c5-d4e3f2a1b0c9", "name": "data-snapshot", "volumeId": "7e3f1b2a-9c8d-4e5f-a6b7-c8d9e0f1a2b3", "size": 100},
+			},
+		},
+		{
+			table: "ovh_cloud_flavor",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "b2-7-gra11", "name": "b2-7", "ram": 7000, "vcpus": 2, "os_type": "linux", "available": true},
+			},
+		},
+		{
+			table: "ovh_cloud_image",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "d3a8b3a4-1a2b-4c5d-8e9f-0a1b2c3d4e5f", "name": "Debian 12", "visibility": "public", "size": 2.5, "user": "debian"},
+			},
+		},
+		{
+			table: "ovh_cloud_ssh_key",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "5a6b7c", "name": "laptop"},
+			},
+		},
+		{
+			table: "ovh_cloud_storage_swift",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "R1JBMTE6YmFja3Vwcw==", "name": "backups", "stored_objects": 42, "stored_bytes": 1048576},
+			},
+		},
+		{
+			table: "ovh_cloud_storage_s3",
+			quals: testQuals{"region": "GRA"},
+			key:   "name",
+			rows: []row{
+				{"project_id": testProject1, "name": "assets", "region": "GRA", "objects_count": 10, "encryption_sse_algorithm": "AES256"},
+			},
+		},
+		{
+			table: "ovh_cloud_region",
+			key:   "name",
+			rows: []row{
+				{"project_id": testProject1, "name": "GRA11", "continent_code": "EU", "status": "UP"},
+				{"project_id": testProject2, "name": "SBG5", "continent_code": "EU", "status": "UP"},
+			},
+		},
+		{
+			table: "ovh_cloud_database",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "2f1e0d9c-8b7a-4654-a3b2-c1d0e9f8a7b6", "engine": "mongodb", "plan": "essential", "node_number": 1, "created_at": "2024-05-01T10:00:00Z"},
+			},
+		},
+		{
+			table: "ovh_cloud_postgres",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d", "engine": "postgresql", "plan": "business", "node_number": 2},
+			},
+		},
+		{
+			table: "ovh_cloud_data_job",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "c0d1e2f3-a4b5-4c6d-8e7f-a0b1c2d3e4f5", "name": "nightly-etl", "engine": "spark", "status": "COMPLETED", "started_at": "2024-06-01T01:00:00Z"},
+			},
+		},
+		{
+			table: "ovh_cloud_ai_app",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "a1b2c3d4-e5f6-4a7b-8c9d-e0f1a2b3c4d5", "name": "inference", "state": "RUNNING", "replicas": 2},
+			},
+		},
+		{
+			table: "ovh_cloud_ai_job",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "b2c3d4e5-f6a7-4b8c-9d0e-f1a2b3c4d5e6", "name": "training", "state": "DONE"},
+			},
+		},
+		{
+			table: "ovh_cloud_ai_notebook",
+			key:   "id",
+			rows: []row{
+				{"project_id": testProject1, "id": "c3d4e5f6-a7b8-4c9d-8e0f-a1b2c3d4e5f6", "name": "exploration", "framework": "pytorch", "editor": "jupyterlab", "state": "STOPPED"},
+			},
+		},
+		{
+			table: "ovh_bill",
+			key:   "id",
+			rows: []row{
+				{"id": "FR12345678", "date": "2024-08-01T00:00:00+02:00", "order_id": 987654, "category": "autorenew", "price_with_tax": 12, "price_without_tax": 10, "tax": 2, "title": "FR12345678"},
+			},
+		},
+		{
+			table: "ovh_bill_detail",
+			quals: testQuals{"bill_id": "FR12345678"},
+			key:   "id",
+			rows: []row{
+				{"id": "FR12345678-1", "bill_id": "FR12345678", "date": "2024-08-01T00:00:00+02:00", "domain": testProject1, "period_start": "2024-07-01T00:00:00Z", "period_end": "2024-07-31T00:00:00Z", "total_price": 10},
+			},
+		},
+		{
+			table: "ovh_refund",
+			key:   "id",
+			rows: []row{
+				{"id": "AFR1234", "original_bill_id": "FR12345678", "price_with_tax": -6, "tax": -1},
+			},
+		},
+		{
+			table: "ovh_refund_detail",
+			quals: testQuals{"refund_id": "AFR1234"},
+			key:   "id",
+			rows: []row{
+				{"id": "AFR1234-1", "refund_id": "AFR1234", "description": "Unused period", "total_price": -5, "title": "Unused period"},
+			},
+		},
+		{
+			table: "ovh_log_self",
+			key:   "id",
+			rows: []row{
+				{"id": 4240, "method": "POST"},
+				{"id": 4242, "account": "ab12345-ovh", "ip": "192.0.2.10", "method": "GET", "path": "/cloud/project/" + testProject1},
+				{"id": 4243, "method": "DELETE"},
+			},
+		},
+		{
+			table: "ovh_log_service",
+			key:   "id",
+			rows: []row{
+				{"id": 1001, "account": "ab12345-ovh/deploy", "method": "GET", "route": "/dedicated/server/{serviceName}", "date": "2024-09-01T08:00:00+02:00"},
+				{"id": 1002, "account": "ab12345-ovh/deploy", "method": "POST"},
+			},
+		},
+		{
+			table: "ovh_dedicated_server",
+			key:   "name",
+			rows: []row{
+				{"name": "ns3000000.ip-192-0-2.eu", "server_id": 1234567, "ip": "192.0.2.20", "datacenter": "rbx8", "monitoring": true, "boot_script": nil, "iam_urn": "urn:v1:eu:resource:dedicatedServer:ns3000000.ip-192-0-2.eu", "title": "ns3000000.ip-192-0-2.eu", "akas": []string{"urn:v1:eu:resource:dedicatedServer:ns3000000.ip-192-0-2.eu"}, "tags": map[string]string{"team": "infra"}},
+			},
+		},
+		{
+			table: "ovh_ceph",
+			key:   "id",
+			rows: []row{
+				{"id": "94d1e1c8-6c2a-4e36-9a0b-2f9c5f3d6b71", "service_name": "94d1e1c8-6c2a-4e36-9a0b-2f9c5f3d6b71", "region": "GRA", "size": 3, "state": "ACTIVE"},
+			},
+		},
+		{
+			table: "ovh_iam_resource",
+			key:   "name",
+			rows: []row{
+				{"name": testProject1, "type": "publicCloudProject", "display_name": "production", "tags": map[string]string{"environment": "production"}, "title": "production", "akas": []string{"urn:v1:eu:resource:publicCloudProject:" + testProject1}},
+				{"name": "ns3000000.ip-192-0-2.eu", "type": "dedicatedServer", "owner": "ab12345-ovh"},
+			},
+		},
+		{
+			table: "ovh_iplb",
+			key:   "service_name",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "display_name": "production", "ipv4": "198.51.100.80", "ipv6": "2001:db8::80", "state": "ok", "zone": []string{"gra", "rbx"}, "vrack_eligibility": true, "vrack_name": "pn-123456", "title": "production", "akas": []string{"urn:v1:eu:resource:ipLoadbalancing:loadbalancer-aaa111"}, "tags": map[string]string{"environment": "production"}},
+				{"service_name": "loadbalancer-bbb222", "ipv6": nil, "vrack_eligibility": false, "vrack_name": nil, "ssl_configuration": nil, "tags": nil},
+			},
+		},
+		{
+			table: "ovh_iplb_frontend",
+			key:   "id",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "type": "http", "id": 1, "display_name": "http", "port": "80", "default_farm_id": 10, "ssl": false, "default_ssl_id": nil, "redirect_location": nil},
+				{"service_name": "loadbalancer-aaa111", "type": "http", "id": 2, "ssl": true, "default_ssl_id": 100, "hsts": true, "dedicated_ipfo": []string{"203.0.113.80/32"}, "http_header": []string{"X-Forwarded-Proto https"}},
+				{"service_name": "loadbalancer-aaa111", "type": "tcp", "id": 3, "port": "22", "zone": "gra", "default_farm_id": nil, "disabled": true, "allowed_source": []string{"192.0.2.0/24"}, "http_header": nil},
+			},
+		},
+		{
+			table: "ovh_iplb_frontend",
+			quals: testQuals{"type": "tcp"},
+			key:   "id",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "type": "tcp", "id": 3},
+			},
+		},
+		{
+			table: "ovh_iplb_farm",
+			key:   "id",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "type": "http", "id": 10, "display_name": "web", "port": 8080, "balance": "roundrobin", "stickiness": "cookie", "vrack_network_id": nil, "probe": row{"type": "http", "method": "GET", "url": "/health", "interval": 30, "match": "status", "pattern": "200", "negate": false, "forceSsl": false, "port": nil}},
+			},
+		},
+		{
+			table: "ovh_iplb_server",
+			key:   "id",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "type": "http", "farm_id": 10, "id": 20, "address": "10.0.0.12", "port": nil, "status": "active", "backup": false, "probe": true, "proxy_protocol_version": nil, "server_state": []row{{"zone": "gra", "state": "UP"}}},
+				{"service_name": "loadbalancer-aaa111", "farm_id": 10, "id": 21, "port": 8081, "status": "inactive", "backup": true, "ssl": true, "proxy_protocol_version": "v2"},
+			},
+		},
+		{
+			table: "ovh_iplb_server",
+			quals: testQuals{"farm_id": 11},
+			rows:  []row{},
+		},
+		{
+			table: "ovh_iplb_route",
+			key:   "id",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "type": "http", "id": 30, "display_name": "force-https", "frontend_id": 1, "weight": 1, "action": row{"type": "redirect", "status": 302, "target": "https://${host}${path}${arguments}"}, "rules": []row{{"ruleId": 40, "field": "protocol", "subField": nil, "match": "is", "negate": false, "pattern": "http"}}},
+			},
+		},
+		{
+			table: "ovh_iplb_route",
+			quals: testQuals{"type": "udp"},
+			rows:  []row{},
+		},
+		{
+			table: "ovh_iplb_ssl",
+			key:   "id",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "id": 100, "display_name": nil, "type": "built", "san": []string{"www.example.com", "example.com"}, "expire_date": "2026-11-01T12:00:00Z", "title": nil},
+				{"service_name": "loadbalancer-aaa111", "id": 101, "type": "custom", "subject": "CN=api.example.com", "title": "api"},
+			},
+		},
+		{
+			table: "ovh_iplb_pending_change",
+			key:   "zone",
+			rows: []row{
+				{"service_name": "loadbalancer-aaa111", "zone": "gra", "number": 2, "title": "gra"},
+			},
+		},
+		{
+			table: "ovh_domain",
+			key:   "name",
+			rows: []row{
+				{"name": "example.com", "offer": "gold", "transfer_lock_status": "locked", "name_server_type": "hosted", "name_servers": []row{{"id": 101, "host": "dns10.ovh.net", "ip": "", "isUsed": true, "toDelete": false}, {"id": 102, "host": "ns10.ovh.net", "ip": "", "isUsed": true, "toDelete": false}}, "dnssec_supported": true, "dnssec_status": "enabled", "whois_owner": "1234567", "expiration_date": "2026-12-14", "auto_renew": true, "title": "example.com", "akas": []string{"urn:v1:eu:resource:domain:example.com"}, "tags": map[string]string{"env": "prod"}},
+				{"name": "example.org", "transfer_lock_status": "unlocked", "name_server_type": "external", "dnssec_status": nil, "creation_date": "2021-02-01", "auto_renew": false, "tags": nil},
+			},
+		},
+		{
+			table: "ovh_dns_zone",
+			key:   "name",
+			rows: []row{
+				{"name": "example.com", "name_servers": []string{"dns10.ovh.net", "ns10.ovh.net"}, "has_dns_anycast": false, "dnssec_supported": true, "dnssec_status": "enabled", "last_update": "2026-09-01T10:00:00Z", "title": "example.com", "akas": []string{"urn:v1:eu:resource:dnsZone:example.com"}},
+			},
+		},
+		{
+			table: "ovh_dns_record",
+			key:   "id",
+			rows: []row{
+				{"zone_name": "example.com", "id": 5000001, "field_type": "A", "sub_domain": "", "target": "203.0.113.10", "ttl": 0},
+				{"zone_name": "example.com", "id": 5000002, "field_type": "CNAME", "sub_domain": "www", "target": "example.com.", "ttl": 3600},
+				{"zone_name": "example.com", "id": 5000003, "field_type": "CNAME", "sub_domain": "shop", "target": "shops.example-saas.net."},
+			},
+		},
+		{
+			table: "ovh_dns_zone_history",
+			key:   "creation_date",
+			rows: []row{
+				{"zone_name": "example.com", "creation_date": "2026-08-15T14:30:00Z", "zone_file_url": "https://dns.ovh.net/zone/example.com/2026-08-15T14:30:00Z", "title": "example.com 2026-08-15T14:30:00Z"},
+				{"zone_name": "example.com", "creation_date": "2026-09-01T10:00:00Z", "zone_file_url": "https://dns.ovh.net/zone/example.com/2026-09-01T10:00:00Z", "title": "example.com 2026-09-01T10:00:00Z"},
+			},
+		},
+		{
+			table: "ovh_ip",
+			key:   "ip",
+			rows: []row{
+				{"ip": "203.0.113.16/28", "type": "failover", "description": "mail", "routed_to": "ns3000000.ip-192-0-2.eu", "country": "fr", "campus": "GRA", "version": 4, "is_additional_ip": true, "can_be_terminated": true, "organisation_id": nil, "firewall": []row{{"ipOnFirewall": "203.0.113.17", "enabled": true, "state": "ok"}}, "title": "mail", "akas": []string{"urn:v1:eu:resource:ip:203.0.113.16/28"}, "tags": map[string]string{"team": "mail"}},
+				{"ip": "192.0.2.10/32", "type": "dedicated", "description": nil, "country": nil, "is_additional_ip": false, "firewall": []row{}, "tags": nil},
+			},
+		},
+		{
+			table: "ovh_ip_reverse",
+			key:   "ip_reverse",
+			rows: []row{
+				{"ip": "203.0.113.16/28", "ip_reverse": "203.0.113.17", "reverse": "mail.example.com.", "title": "mail.example.com."},
+			},
+		},
+		{
+			table: "ovh_ip_firewall_rule",
+			key:   "sequence",
+			rows: []row{
+				{"ip": "203.0.113.16/28", "ip_on_firewall": "203.0.113.17", "firewall_enabled": true, "sequence": 0, "action": "permit", "protocol": "tcp", "source": "any", "source_port": nil, "destination_port": "eq 25", "fragments": false, "tcp_option": nil, "state": "ok", "creation_date": "2025-06-10T09:00:00+02:00"},
+				{"ip": "203.0.113.16/28", "sequence": 1, "action": "deny", "protocol": "ipv4", "destination_port": nil, "rule": "deny ipv4 any 203.0.113.17/32", "title": "deny ipv4 any 203.0.113.17/32"},
+			},
+		},
+		{
+			table: "ovh_ip_mitigation",
+			key:   "ip_on_mitigation",
+			rows: []row{
+				{"ip": "192.0.2.10/32", "ip_on_mitigation": "192.0.2.10", "auto": true, "permanent": false, "state": "ok", "title": "192.0.2.10"},
+			},
+		},
+		{
+			table: "ovh_ip_move",
+			key:   "service",
+			rows: []row{
+				{"ip": "203.0.113.16/28", "destination_type": "cloudProject", "service": testProject1, "nexthop": nil, "title": testProject1},
+				{"ip": "203.0.113.16/28", "destination_type": "dedicatedServer", "service": "ns3000001.ip-192-0-2.eu", "nexthop": []string{}},
+				{"ip": "203.0.113.16/28", "destination_type": "dedicatedServer", "service": "ns3000002.ip-192-0-2.eu", "nexthop": []string{"192.0.2.254"}},
+			},
+		},
+		{
+			table: "ovh_ip_move",
+			quals: testQuals{"ip": cidrValue("192.0.2.10/32")},
+			rows:  []row{},
+		},
+		{
+			table: "ovh_vrack",
+			key:   "service_name",
+			rows: []row{
+				{"service_name": "pn-123456", "name": "production", "description": "Production private network", "title": "production", "akas": []string{"urn:v1:eu:resource:vrack:pn-123456"}, "tags": map[string]string{"env": "prod"}},
+				{"service_name": "pn-654321", "name": nil, "description": nil, "title": "pn-654321", "tags": nil},
+			},
+		},
+		{
+			table: "ovh_vrack_member",
+			key:   "id",
+			rows: []row{
+				{"service_name": "pn-123456", "type": "cloudProject", "id": testProject1},
+				{"service_name": "pn-654321", "type": "cloudProject", "id": testProject2},
+				{"service_name": "pn-123456", "type": "dedicatedServer", "id": "ns3000000.ip-192-0-2.eu"},
+				{"service_name": "pn-123456", "type": "ip", "id": "203.0.113.16/28"},
+				{"service_name": "pn-123456", "type": "ipLoadbalancing", "id": "loadbalancer-aaa111", "title": "loadbalancer-aaa111"},
+			},
+		},
+		{
+			table: "ovh_vrack_member",
+			quals: testQuals{"service_name": "pn-123456", "type": "dedicatedServer"},
+			key:   "id",
+			rows: []row{
+				{"service_name": "pn-123456", "type": "dedicatedServer", "id": "ns3000000.ip-192-0-2.eu"},
+			},
+		},
+		{
+			table: "ovh_vrack_member",
+			quals: testQuals{"type": "unknown"},
+			rows:  []row{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			api := newFakeAPI(t)
+			rows, err := newTestQuery(t, api, test.table, test.quals).List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertRows(t, rows, test.key, test.rows)
+		})
+	}
+}
+
+func TestGetTables(t *testing.T) {
+	tests := []struct {
+		table string
+		quals testQuals
+		row   row
+	}{
+		{
+			table: "ovh_cloud_project",
+			quals: testQuals{"id": testProject2},
+			row:   row{"id": testProject2, "name": "staging", "plan_code": "project.2018"},
+		},
+		{
+			table: "ovh_cloud_instance",
+			quals: testQuals{"project_id": testProject1, "id": "f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11"},
+			row:   row{"project_id": testProject1, "id": "f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11", "name": "web-1"},
+		},
+		{
+			table: "ovh_cloud_instance_interface",
+			quals: testQuals{"project_id": testProject1, "instance_id": "f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11", "id": "1e2f3a4b-5c6d-4e7f-8a9b-0c1d2e3f4a5b"},
+			row:   row{"instance_id": "f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11", "type": "private", "mac_address": "fa:16:3e:00:00:02"},
+		},
+		{
+			table: "ovh_cloud_network_private",
+			quals: testQuals{"project_id": testProject1, "id": "pn-123456_42"},
+			row:   row{"project_id": testProject1, "name": "admin", "vlan_id": 42},
+		},
+		{
+			table: "ovh_cloud_gateway",
+			quals: testQuals{"project_id": testProject1, "region": "GRA11", "id": "5e6f7a8b-9c0d-4e1f-8a2b-3c4d5e6f7a8b"},
+			row:   row{"region": "GRA11", "name": "backend-gateway"},
+		},
+		{
+			table: "ovh_cloud_loadbalancer",
+			quals: testQuals{"project_id": testProject1, "region": "GRA11", "id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"},
+			row:   row{"region": "GRA11", "name": "web", "updated_at": "2024-03-02T09:00:00Z"},
+		},
+		{
+			table: "ovh_cloud_loadbalancer_listener",
+			quals: testQuals{"project_id": testProject1, "region": "GRA11", "id": "b2c3d4e5-f6a7-4b8c-9d0e-1f2a3b4c5d6f"},
+			row:   row{"region": "GRA11", "name": "https", "description": "Public website", "port": 443},
+		},
+		{
+			table: "ovh_cloud_loadbalancer_pool",
+			quals: testQuals{"project_id": testProject1, "region": "GRA11", "id": "c1d2e3f4-a5b6-4c7d-8e9f-0a1b2c3d4e5f"},
+			row:   row{"region": "GRA11", "name": "web-servers"},
+		},
+		{
+			table: "ovh_cloud_volume",
+			quals: testQuals{"project_id": testProject1, "id": "7e3f1b2a-9c8d-4e5f-a6b7-c8d9e0f1a2b3"},
+			row:   row{"project_id": testProject1, "name": "data"},
+		},
+		{
+			table: "ovh_cloud_volume_snapshot",
+			quals: testQuals{"project_id": testProject1, "id": "3b2a1c0d-e9f8-4a7b-b6c5-d4e3f2a1b0c9"},
+			row:   row{"project_id": testProject1, "name": "data-snapshot"},
+		},
+		{
+			table: "ovh_cloud_flavor",
+			quals: testQuals{"project_id": testProject1, "id": "b2-7-gra11"},
+			row:   row{"project_id": testProject1, "name": "b2-7", "plan_codes_hourly": "b2-7.consumption"},
+		},
+		{
+			table: "ovh_cloud_image",
+			quals: testQuals{"project_id": testProject1, "id": "d3a8b3a4-1a2b-4c5d-8e9f-0a1b2c3d4e5f"},
+			row:   row{"project_id": testProject1, "name": "Debian 12"},
+		},
+		{
+			table: "ovh_cloud_ssh_key",
+			quals: testQuals{"project_id": testProject1, "id": "5a6b7c"},
+			row:   row{"project_id": testProject1, "name": "laptop"},
+		},
+		{
+			table: "ovh_cloud_storage_swift",
+			quals: testQuals{"project_id": testProject1, "id": "R1JBMTE6YmFja3Vwcw=="},
+			row:   row{"project_id": testProject1, "name": "backups"},
+		},
+		{
+			table: "ovh_cloud_storage_s3",
+			quals: testQuals{"project_id": testProject1, "region": "GRA", "name": "assets"},
+			row:   row{"project_id": testProject1, "name": "assets", "virtual_host": "https://assets.s3.gra.io.cloud.ovh.net/"},
+		},
+		{
+			table: "ovh_cloud_region",
+			quals: testQuals{"project_id": testProject2, "name": "SBG5"},
+			row:   row{"project_id": testProject2, "name": "SBG5", "datacenter_location": "SBG"},
+		},
+		{
+			table: "ovh_cloud_database",
+			quals: testQuals{"project_id": testProject1, "id": "2f1e0d9c-8b7a-4654-a3b2-c1d0e9f8a7b6"},
+			row:   row{"project_id": testProject1, "engine": "mongodb", "version": "6.0"},
+		},
+		{
+			table: "ovh_cloud_postgres",
+			quals: testQuals{"project_id": testProject1, "id": "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"},
+			row:   row{"project_id": testProject1, "engine": "postgresql", "version": "15"},
+		},
+		{
+			table: "ovh_cloud_data_job",
+			quals: testQuals{"project_id": testProject1, "id": "c0d1e2f3-a4b5-4c6d-8e7f-a0b1c2d3e4f5"},
+			row:   row{"project_id": testProject1, "name": "nightly-etl"},
+		},
+		{
+			table: "ovh_cloud_ai_app",
+			quals: testQuals{"project_id": testProject1, "id": "a1b2c3d4-e5f6-4a7b-8c9d-e0f1a2b3c4d5"},
+			row:   row{"project_id": testProject1, "name": "inference"},
+		},
+		{
+			table: "ovh_cloud_ai_job",
+			quals: testQuals{"project_id": testProject1, "id": "b2c3d4e5-f6a7-4b8c-9d0e-f1a2b3c4d5e6"},
+			row:   row{"project_id": testProject1, "name": "training"},
+		},
+		{
+			table: "ovh_cloud_ai_notebook",
+			quals: testQuals{"project_id": testProject1, "id": "c3d4e5f6-a7b8-4c9d-8e0f-a1b2c3d4e5f6"},
+			row:   row{"project_id": testProject1, "name": "exploration"},
+		},
+		{
+			table: "ovh_bill",
+			quals: testQuals{"id": "FR12345678"},
+			row:   row{"id": "FR12345678", "pdf_url": "https://www.ovh.com/cgi-bin/order/facture.pdf?reference=FR12345678"},
+		},
+		{
+			table: "ovh_bill_detail",
+			quals: testQuals{"bill_id": "FR12345678", "id": "FR12345678-1"},
+			row:   row{"id": "FR12345678-1", "description": "Public Cloud instance b2-7"},
+		},
+		{
+			table: "ovh_refund",
+			quals: testQuals{"id": "AFR1234"},
+			row:   row{"id": "AFR1234", "order_id": 987655},
+		},
+		{
+			table: "ovh_refund_detail",
+			quals: testQuals{"refund_id": "AFR1234", "id": "AFR1234-1"},
+			row:   row{"id": "AFR1234-1", "unit_price": -5},
+		},
+		{
+			table: "ovh_cloud_kube_cluster",
+			quals: testQuals{"project_id": testProject1, "id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"},
+			row:   row{"id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "region": "GRA11", "ip_restrictions": []string{"192.0.2.0/24"}},
+		},
+		{
+			table: "ovh_cloud_kube_node_pool",
+			quals: testQuals{"project_id": testProject1, "kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "id": "d4e5f6a7-b8c9-4d0e-8f1a-2b3c4d5e6f7a"},
+			row:   row{"kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "name": "default", "size_status": "CAPACITY_OK"},
+		},
+		{
+			table: "ovh_cloud_kube_node",
+			quals: testQuals{"project_id": testProject1, "kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "id": "e5f6a7b8-c9d0-4e1f-9a2b-3c4d5e6f7a8b"},
+			row:   row{"kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f", "instance_id": "9a8b7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"},
+		},
+		{
+			table: "ovh_cloud_registry",
+			quals: testQuals{"project_id": testProject1, "id": "a7b8c9d0-e1f2-4a3b-8c4d-5e6f7a8b9c0d"},
+			row:   row{"name": "apps", "plan": row{"id": "9f14c3b9-3c8b-4b0a-9b5e-2f0c1d2e3f4a", "name": "SMALL", "code": "registry.s-plan-equivalent.hour.consumption", "registryLimits": row{"imageStorage": 214748364800, "parallelRequest": 15}, "features": row{"vulnerability": false}}},
+		},
+		{
+			table: "ovh_cloud_registry_user",
+			quals: testQuals{"project_id": testProject1, "registry_id": "a7b8c9d0-e1f2-4a3b-8c4d-5e6f7a8b9c0d", "id": 3},
+			row:   row{"registry_id": "a7b8c9d0-e1f2-4a3b-8c4d-5e6f7a8b9c0d", "user": "ci"},
+		},
+		{
+			table: "ovh_log_self",
+			quals: testQuals{"id": "4242"},
+			row:   row{"id": 4242, "route": "/cloud/project/{serviceName}"},
+		},
+		{
+			table: "ovh_log_service",
+			quals: testQuals{"id": "1002"},
+			row:   row{"id": 1002, "path": "/dedicated/server/ns3000000.ip-192-0-2.eu/reboot"},
+		},
+		{
+			table: "ovh_dedicated_server",
+			quals: testQuals{"name": "ns3000000.ip-192-0-2.eu"},
+			row:   row{"name": "ns3000000.ip-192-0-2.eu", "os": "debian12_64"},
+		},
+		{
+			table: "ovh_ceph",
+			quals: testQuals{"id": "94d1e1c8-6c2a-4e36-9a0b-2f9c5f3d6b71"},
+			row:   row{"id": "94d1e1c8-6c2a-4e36-9a0b-2f9c5f3d6b71", "status": "INSTALLED"},
+		},
+		{
+			table: "ovh_iplb",
+			quals: testQuals{"service_name": "loadbalancer-aaa111"},
+			row:   row{"service_name": "loadbalancer-aaa111", "offer": "lb1", "ssl_configuration": "intermediate"},
+		},
+		{
+			table: "ovh_iplb_frontend",
+			quals: testQuals{"service_name": "loadbalancer-aaa111", "type": "http", "id": 2},
+			row:   row{"display_name": "https", "port": "443"},
+		},
+		{
+			table: "ovh_iplb_farm",
+			quals: testQuals{"service_name": "loadbalancer-aaa111", "type": "http", "id": 10},
+			row:   row{"display_name": "web"},
+		},
+		{
+			table: "ovh_iplb_server",
+			quals: testQuals{"service_name": "loadbalancer-aaa111", "type": "http", "farm_id": 10, "id": 21},
+			row:   row{"farm_id": 10, "display_name": "web-spare"},
+		},
+		{
+			table: "ovh_iplb_route",
+			quals: testQuals{"service_name": "loadbalancer-aaa111", "type": "http", "id": 30},
+			row:   row{"display_name": "force-https"},
+		},
+		{
+			table: "ovh_iplb_ssl",
+			quals: testQuals{"service_name": "loadbalancer-aaa111", "id": 101},
+			row:   row{"display_name": "api", "fingerprint": "12:34:56:78"},
+		},
+		{
+			table: "ovh_domain",
+			quals: testQuals{"name": "example.org"},
+			row:   row{"name": "example.org", "renew": row{"automatic": false, "deleteAtExpiration": true, "forced": false, "manualPayment": true, "period": 0}, "owner_contact": row{"id": 1234567, "legalForm": "corporation", "organisationName": "Example SAS", "firstName": "Jane", "lastName": "Doe", "email": "hostmaster@example.com", "phone": "+33.123456789", "language": "fr_FR", "address": row{"line1": "1 rue de l'Exemple", "zip": "59100", "city": "Roubaix", "country": "FR"}}},
+		},
+		{
+			table: "ovh_ip",
+			quals: testQuals{"ip": cidrValue("192.0.2.10/32")},
+			row:   row{"ip": "192.0.2.10/32", "type": "dedicated", "title": "192.0.2.10/32"},
+		},
+		{
+			table: "ovh_vrack",
+			quals: testQuals{"service_name": "pn-123456"},
+			row:   row{"service_name": "pn-123456", "name": "production"},
+		},
+		{
+			table: "ovh_dns_zone",
+			quals: testQuals{"name": "example.com"},
+			row:   row{"name": "example.com", "dnssec_status": "enabled"},
+		},
+		{
+			table: "ovh_dns_record",
+			quals: testQuals{"zone_name": "example.com", "id": 5000002},
+			row:   row{"field_type": "CNAME", "sub_domain": "www"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			api := newFakeAPI(t)
+			row, err := newTestQuery(t, api, test.table, test.quals).Get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertRow(t, row, test.row)
+		})
+	}
+}
+
+func TestGetFetchesOnce(t *testing.T) {
+	tests := []struct {
+		table string
+		quals testQuals
+	}{
+		{"ovh_cloud_project", testQuals{"id": testProject2}},
+		{"ovh_cloud_database", testQuals{"project_id": testProject1, "id": "2f1e0d9c-8b7a-4654-a3b2-c1d0e9f8a7b6"}},
+		{"ovh_cloud_postgres", testQuals{"project_id": testProject1, "id": "8a7b6c5d-4e3f-4a2b-9c1d-0e9f8a7b6c5d"}},
+		{"ovh_cloud_data_job", testQuals{"project_id": testProject1, "id": "c0d1e2f3-a4b5-4c6d-8e7f-a0b1c2d3e4f5"}},
+		{"ovh_bill", testQuals{"id": "FR12345678"}},
+		{"ovh_refund", testQuals{"id": "AFR1234"}},
+		{"ovh_ceph", testQuals{"id": "94d1e1c8-6c2a-4e36-9a0b-2f9c5f3d6b71"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			api := newFakeAPI(t)
+			if _, err := newTestQuery(t, api, test.table, test.quals).Get(); err != nil {
+				t.Fatal(err)
+			}
+			// The hydrate functions of the columns reuse the details of the get call
+			requests := api.Requests()
+			if len(requests) != len(slices.Compact(slices.Sorted(slices.Values(requests)))) {
+				t.Errorf("got the requests %v, expected each of them once", requests)
+			}
+		})
+	}
+}
+
+func TestGetIgnoredErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		table string
+		quals testQuals
+	}{
+		{"not found", "ovh_cloud_instance", testQuals{"project_id": testProject1, "id": "unknown"}},
+		{"not found", "ovh_cloud_flavor", testQuals{"project_id": testProject1, "id": "unknown"}},
+		{"not found", "ovh_bill", testQuals{"id": "unknown"}},
+		{"not found", "ovh_dedicated_server", testQuals{"name": "unknown"}},
+		{"expired", "ovh_dedicated_server", testQuals{"name": "ns3000001.ip-192-0-2.eu"}},
+		{"suspended", "ovh_cloud_project", testQuals{"id": "0a1b2c3d4e5f60718293a4b5c6d7e8f9"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.table+" "+test.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			row, err := newTestQuery(t, api, test.table, test.quals).Get()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if row != nil {
+				t.Fatalf("got %v, expected no row", row)
+			}
+		})
+	}
+}
+
+func TestListIgnoredErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		table string
+		path  string
+		code  int
+		rows  int
+	}{
+		{"expired project", "ovh_cloud_ssh_key", "/1.0/cloud/project/" + testProject2 + "/sshkey", 460, 1},
+		{"deleted instance", "ovh_cloud_instance_interface", "/1.0/cloud/project/" + testProject1 + "/instance/f6a0e0e4-58f2-4b6e-9d4f-0a7c1e3b9c11/interface", 404, 0},
+		{"expired load balancer", "ovh_iplb_pending_change", "/1.0/ipLoadbalancing/loadbalancer-aaa111/pendingChanges", 460, 0},
+		{"load balancer without a type", "ovh_iplb_frontend", "/1.0/ipLoadbalancing/loadbalancer-aaa111/http/frontend", 404, 1},
+		{"expired zone", "ovh_dns_zone_history", "/1.0/domain/zone/example.com/history", 460, 0},
+		{"removed vRack", "ovh_vrack_member", "/1.0/vrack/pn-654321/cloudProject", 404, 4},
+		{"vRack without a type", "ovh_vrack_member", "/1.0/vrack/pn-123456/cloudProject", 404, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			api.Fail(test.path, fakeFailure{code: test.code})
+			rows, err := newTestQuery(t, api, test.table, nil).List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rows) != test.rows {
+				t.Fatalf("got %d rows, expected %d: %v", len(rows), test.rows, rows)
+			}
+		})
+	}
+}
+
+func TestRequestsAreSigned(t *testing.T) {
+	api := newFakeAPI(t)
+	query := newTestQuery(t, api, "ovh_cloud_project", nil)
+	rows, err := query.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, expected 2", len(rows))
+	}
+
+	query.applicationKey = "other"
+	if _, err := query.List(); err == nil {
+		t.Fatal("expected the request with an invalid application key to be rejected")
+	}
+}
+
+func TestCredentialsAreNotMixedWithEnvironment(t *testing.T) {
+	// The application key of the connection config is used, the OAuth2
+	// credentials of the environment are ignored
+	t.Setenv("OVH_CLIENT_ID", "a7c9bd4f1e2d3c6b")
+	t.Setenv("OVH_CLIENT_SECRET", "ZfsNhuVocbyRetKeduvejAbmiddyevEf")
+	t.Setenv("OVH_ACCESS_TOKEN", "eyJhbGciOiJIUzI1NiJ9")
+
+	api := newFakeAPI(t)
+	rows, err := newTestQuery(t, api, "ovh_cloud_project", nil).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("got %d rows, expected 2", len(rows))
+	}
+}
+
+func TestApiRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		quals    testQuals
+		request  string
+		rows     []row
+		rowCount int
+	}{
+		{
+			name:    "array",
+			quals:   testQuals{"path": "/vps"},
+			request: "/1.0/vps",
+			rows: []row{
+				{"path": "/vps", "status_code": 200, "response": "vps-0a1b2c3d.vps.ovh.net", "error": nil},
+				{"path": "/vps", "status_code": 200, "response": "vps-4e5f6a7b.vps.ovh.net"},
+			},
+		},
+		{
+			name:    "object",
+			quals:   testQuals{"path": "/me"},
+			request: "/1.0/me",
+			rows: []row{
+				{"status_code": 200, "response": row{"nichandle": "ab12345-ovh", "firstname": "Jane", "name": "Doe", "country": "FR", "currency": row{"code": "EUR", "symbol": "EURO"}}},
+			},
+		},
+		{
+			name:    "query params",
+			quals:   testQuals{"path": "/vps", "query_params": jsonValue(`{"iamTags": "{\"env\":[\"prod\"]}", "id": [1, 2]}`)},
+			request: "/1.0/vps?iamTags=%7B%22env%22%3A%5B%22prod%22%5D%7D&id=1&id=2",
+			rows: []row{
+				{"query_params": row{"iamTags": `{"env":["prod"]}`, "id": []int{1, 2}}, "response": "vps-0a1b2c3d.vps.ovh.net"},
+				{"response": "vps-4e5f6a7b.vps.ovh.net"},
+			},
+		},
+		{
+			name:    "v2",
+			quals:   testQuals{"path": "/v2/iam/resource"},
+			request: "/v2/iam/resource",
+			rows: []row{
+				{"status_code": 200, "response": row{"id": "b0a1c2d3-0000-4000-8000-000000000001", "urn": "urn:v1:eu:resource:publicCloudProject:" + testProject1, "name": testProject1, "displayName": "production", "type": "publicCloudProject", "owner": "ab12345-ovh", "tags": row{"environment": "production"}}},
+				{"status_code": 200},
+			},
+		},
+		{
+			name:    "error",
+			quals:   testQuals{"path": "/dedicated/nasha"},
+			request: "/1.0/dedicated/nasha",
+			rows: []row{
+				{"status_code": 404, "response": nil, "error": `OVHcloud API error (status code 404): Client::NotFound: "The requested object (/1.0/dedicated/nasha) does not exist"`},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			rows, err := newTestQuery(t, api, "ovh_api_request", test.quals).List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(rows) != len(test.rows) {
+				t.Fatalf("got %d rows, expected %d: %v", len(rows), len(test.rows), rows)
+			}
+			for i := range test.rows {
+				assertRow(t, rows[i], test.rows[i])
+			}
+			if requests := api.Requests(); len(requests) != 1 || requests[0] != test.request {
+				t.Errorf("got requests %v, expected %s", requests, test.request)
+			}
+		})
+	}
+}
+
+func TestApiRequestLimit(t *testing.T) {
+	api := newFakeAPI(t)
+	rows, err := newTestQuery(t, api, "ovh_api_request", testQuals{"path": "/vps"}).Limit(1).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, expected 1", len(rows))
+	}
+}
+
+func TestApiSchema(t *testing.T) {
+	api := newFakeAPI(t)
+
+	t.Run("api", func(t *testing.T) {
+		rows, err := newTestQuery(t, api, "ovh_api_schema", testQuals{"api": "/vps"}).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 7 {
+			t.Fatalf("got %d rows, expected 7", len(rows))
+		}
+		for _, r := range rows {
+			if r["path"] == "/vps/{serviceName}" && r["http_method"] == "PUT" {
+				assertRow(t, r, testQuals{
+					"api_version":       "1.0",
+					"api":               "/vps",
+					"description":       "Alter this object properties",
+					"api_status":        "PRODUCTION",
+					"response_type":     "void",
+					"no_authentication": false,
+					"iam_actions":       []row{{"name": "vps:apiovh:put", "required": true}},
+				})
+				if parameters, ok := r["parameters"].([]interface{}); !ok || len(parameters) != 2 {
+					t.Errorf("parameters = %v, expected the body and serviceName", r["parameters"])
+				}
+				return
+			}
+		}
+		t.Error("the operation PUT /vps/{serviceName} is missing")
+	})
+
+	t.Run("v2", func(t *testing.T) {
+		rows, err := newTestQuery(t, api, "ovh_api_schema", testQuals{"api": "/v2/iam"}).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		assertRows(t, rows, "path", []row{
+			{"api_version": "v2", "api": "/v2/iam", "path": "/v2/iam/policy", "http_method": "GET", "api_status": "BETA"},
+		})
+	})
+
+	t.Run("all", func(t *testing.T) {
+		rows, err := newTestQuery(t, api, "ovh_api_schema", nil).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 8 {
+			t.Errorf("got %d rows, expected 8", len(rows))
+		}
+	})
+
+	t.Run("deprecated", func(t *testing.T) {
+		rows, err := newTestQuery(t, api, "ovh_api_schema", testQuals{"api_version": "1.0"}).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var deprecated []string
+		for _, r := range rows {
+			if r["api_status"] == "DEPRECATED" {
+				deprecated = append(deprecated, r["path"].(string))
+			}
+		}
+		if len(deprecated) != 1 || deprecated[0] != "/vps/{serviceName}/option" {
+			t.Errorf("got the deprecated paths %v", deprecated)
+		}
+	})
+
+	t.Run("unknown api", func(t *testing.T) {
+		rows, err := newTestQuery(t, api, "ovh_api_schema", testQuals{"api": "/unknown"}).List()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(rows) != 0 {
+			t.Errorf("got %d rows, expected none", len(rows))
+		}
+	})
+}
+
+func TestApiSchemaLoaderDir(t *testing.T) {
+	dir := "testdata/schema"
+	loader, err := newAPISchemaLoader(ovhConfig{APISchemaDir: &dir})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for version, expected := range map[string][]string{"1.0": {"/vps"}, "v2": {"/iam"}, "v3": nil} {
+		apis, err := loader.apis(version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(apis, expected) {
+			t.Errorf("apis(%s) = %v, expected %v", version, apis, expected)
+		}
+	}
+}
+
+func TestIamResourcePages(t *testing.T) {
+	api := newFakeAPI(t)
+	api.pageSize = 1
+
+	rows, err := newTestQuery(t, api, "ovh_iam_resource", nil).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertRows(t, rows, "id", []row{
+		{"id": "b0a1c2d3-0000-4000-8000-000000000001", "type": "publicCloudProject"},
+		{"id": "d1e2f3a4-b5c6-4d7e-8f9a-b0c1d2e3f4a5", "type": "dedicatedServer"},
+	})
+	if requests := api.Requests(); len(requests) != 2 {
+		t.Errorf("got the requests %v, expected one per page", requests)
+	}
+}
+
+func TestIamResourceLimit(t *testing.T) {
+	api := newFakeAPI(t)
+	api.pageSize = 1
+
+	rows, err := newTestQuery(t, api, "ovh_iam_resource", nil).Limit(1).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, expected 1", len(rows))
+	}
+	if requests := api.Requests(); len(requests) != 1 {
+		t.Errorf("got the requests %v, expected only the first page", requests)
+	}
+}
+
+func TestIamTagsFilter(t *testing.T) {
+	tests := []struct {
+		table string
+		qual  operatorQual
+		uri   string
+	}{
+		{
+			table: "ovh_iam_resource",
+			qual:  operatorQual{"@>", jsonValue(`{"env": "prod"}`)},
+			uri:   "/v2/iam/resource?tags=" + url.QueryEscape(`{"env":[{"operator":"EQ","value":"prod"}]}`),
+		},
+		{
+			table: "ovh_dedicated_server",
+			qual:  operatorQual{"?", "team"},
+			uri:   "/1.0/dedicated/server?iamTags=" + url.QueryEscape(`{"team":[{"operator":"EXISTS"}]}`),
+		},
+		{
+			table: "ovh_cloud_project",
+			qual:  operatorQual{"?&", []string{"env", "team"}},
+			uri:   "/1.0/cloud/project?iamTags=" + url.QueryEscape(`{"env":[{"operator":"EXISTS"}],"team":[{"operator":"EXISTS"}]}`),
+		},
+		{
+			table: "ovh_iplb",
+			qual:  operatorQual{"@>", jsonValue(`{"env": "prod"}`)},
+			uri:   "/1.0/ipLoadbalancing?iamTags=" + url.QueryEscape(`{"env":[{"operator":"EQ","value":"prod"}]}`),
+		},
+		{
+			table: "ovh_domain",
+			qual:  operatorQual{"?", "team"},
+			uri:   "/1.0/domain?iamTags=" + url.QueryEscape(`{"team":[{"operator":"EXISTS"}]}`),
+		},
+		{
+			table: "ovh_ip",
+			qual:  operatorQual{"@>", jsonValue(`{"team": "mail"}`)},
+			uri:   "/1.0/ip?iamTags=" + url.QueryEscape(`{"team":[{"operator":"EQ","value":"mail"}]}`),
+		},
+		{
+			table: "ovh_vrack",
+			qual:  operatorQual{"?", "env"},
+			uri:   "/1.0/vrack?iamTags=" + url.QueryEscape(`{"env":[{"operator":"EXISTS"}]}`),
+		},
+		{
+			// Tags values are strings, other values are not sent
+			table: "ovh_cloud_project",
+			qual:  operatorQual{"=", jsonValue(`{"count": 1}`)},
+			uri:   "/1.0/cloud/project",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			api := newFakeAPI(t)
+			if _, err := newTestQuery(t, api, test.table, testQuals{"tags": test.qual}).List(); err != nil {
+				t.Fatal(err)
+			}
+			if requests := api.Requests(); len(requests) == 0 || requests[0] != test.uri {
+				t.Errorf("got the requests %v, expected %s first", requests, test.uri)
+			}
+		})
+	}
+}
+
+func TestDateRangeFilter(t *testing.T) {
+	from := time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		table string
+		quals []operatorQual
+		uri   string
+	}{
+		{
+			table: "ovh_bill",
+			quals: []operatorQual{{">=", from}, {"<", to}},
+			uri:   "/1.0/me/bill?date.from=2024-07-01T00%3A00%3A00Z&date.to=2024-09-01T00%3A00%3A00Z",
+		},
+		{
+			// The narrowest range is sent
+			table: "ovh_refund",
+			quals: []operatorQual{{">", from}, {">", to}},
+			uri:   "/1.0/me/refund?date.from=2024-09-01T00%3A00%3A00Z",
+		},
+		{
+			table: "ovh_bill_detail",
+			quals: []operatorQual{{"<=", to}},
+			uri:   "/1.0/me/bill?date.to=2024-09-01T00%3A00%3A00Z",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.table, func(t *testing.T) {
+			api := newFakeAPI(t)
+			if _, err := newTestQuery(t, api, test.table, testQuals{"date": test.quals}).List(); err != nil {
+				t.Fatal(err)
+			}
+			if requests := api.Requests(); len(requests) == 0 || requests[0] != test.uri {
+				t.Errorf("got the requests %v, expected %s first", requests, test.uri)
+			}
+		})
+	}
+}
+
+func TestBillDetailByDate(t *testing.T) {
+	api := newFakeAPI(t)
+	rows, err := newTestQuery(t, api, "ovh_bill_detail", testQuals{"date": operatorQual{">", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}}).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertRows(t, rows, "id", []row{
+		{"id": "FR12345678-1", "bill_id": "FR12345678", "date": "2024-08-01T00:00:00+02:00", "total_price": 10},
+	})
+}
+
+func TestBillDetailWithoutBill(t *testing.T) {
+	api := newFakeAPI(t)
+	api.Fail("/1.0/me/bill/FR12345678", fakeFailure{code: http.StatusNotFound})
+
+	rows, err := newTestQuery(t, api, "ovh_bill_detail", testQuals{"bill_id": "FR12345678"}).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertRows(t, rows, "id", []row{
+		{"id": "FR12345678-1", "bill_id": "FR12345678", "date": nil, "total_price": 10},
+	})
+}
+
+func TestKubeClusterFilter(t *testing.T) {
+	api := newFakeAPI(t)
+	rows, err := newTestQuery(t, api, "ovh_cloud_kube_node_pool", testQuals{"kube_id": "3c4d5e6f-7a8b-4c9d-8e0f-1a2b3c4d5e6f"}).List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rows) != 1 {
+		t.Fatalf("got %d rows, expected 1", len(rows))
+	}
+	// The clusters of the projects are not listed
+	for _, request := range api.Requests() {
+		if strings.HasSuffix(request, "/kube") {
+			t.Errorf("got the request %s, expected the cluster to be fetched directly", request)
+		}
+	}
+}
+
+func TestLogFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		quals    testQuals
+		limit    int64
+		ids      []interface{}
+		requests []string
+	}{
+		{
+			// The oldest log is not fetched
+			name:     "newest first",
+			limit:    2,
+			ids:      []interface{}{4243, 4242},
+			requests: []string{"/1.0/me/api/logs/self", "/1.0/me/api/logs/self/4242", "/1.0/me/api/logs/self/4243"},
+		},
+		{
+			// The details fetched by the list are not fetched again
+			name:     "date",
+			quals:    testQuals{"date": operatorQual{">=", time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)}},
+			ids:      []interface{}{4243, 4242},
+			requests: []string{"/1.0/me/api/logs/self", "/1.0/me/api/logs/self/4240", "/1.0/me/api/logs/self/4242", "/1.0/me/api/logs/self/4243"},
+		},
+		{
+			name:  "date range",
+			quals: testQuals{"date": []operatorQual{{">", time.Date(2024, 8, 1, 0, 0, 0, 0, time.UTC)}, {"<", time.Date(2024, 9, 2, 0, 0, 0, 0, time.UTC)}}},
+			ids:   []interface{}{4242, 4240},
+		},
+		{
+			name:  "method",
+			quals: testQuals{"method": "POST"},
+			ids:   []interface{}{4240},
+		},
+		{
+			name:  "account",
+			quals: testQuals{"account": "ab12345-ovh/deploy"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			query := newTestQuery(t, api, "ovh_log_self", test.quals)
+			if test.limit > 0 {
+				query.Limit(test.limit)
+			}
+			rows, err := query.List()
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ids []interface{}
+			for _, r := range rows {
+				ids = append(ids, r["id"])
+			}
+			if !reflect.DeepEqual(normalize(ids), normalize(test.ids)) {
+				t.Errorf("got the logs %v, expected %v", ids, test.ids)
+			}
+			if test.requests != nil {
+				requests := api.Requests()
+				sort.Strings(requests)
+				if !reflect.DeepEqual(requests, test.requests) {
+					t.Errorf("got the requests %v, expected %v", requests, test.requests)
+				}
+			}
+		})
+	}
+}
+
+func TestLoadBalancerFilter(t *testing.T) {
+	for _, table := range []string{"ovh_cloud_loadbalancer_listener", "ovh_cloud_loadbalancer_pool"} {
+		t.Run(table, func(t *testing.T) {
+			api := newFakeAPI(t)
+			quals := testQuals{"project_id": testProject1, "region": "GRA11", "loadbalancer_id": "a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"}
+			if _, err := newTestQuery(t, api, table, quals).List(); err != nil {
+				t.Fatal(err)
+			}
+			path := strings.TrimPrefix(table, "ovh_cloud_loadbalancer_")
+			uri := "/1.0/cloud/project/" + testProject1 + "/region/GRA11/loadbalancing/" + path + "?loadbalancerId=a1b2c3d4-e5f6-4a7b-8c9d-0e1f2a3b4c5d"
+			if !slices.Contains(api.Requests(), uri) {
+				t.Errorf("got the requests %v, expected %s", api.Requests(), uri)
+			}
+		})
+	}
+}
+
+func TestDNSRecordFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		quals testQuals
+		uri   string
+	}{
+		{"field type and sub domain", testQuals{"field_type": "CNAME", "sub_domain": "www"}, "/1.0/domain/zone/example.com/record?fieldType=CNAME&subDomain=www"},
+		{"field type", testQuals{"field_type": "MX"}, "/1.0/domain/zone/example.com/record?fieldType=MX"},
+		// The apex of the zone cannot be filtered by the API
+		{"apex", testQuals{"sub_domain": ""}, "/1.0/domain/zone/example.com/record"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			test.quals["zone_name"] = "example.com"
+			if _, err := newTestQuery(t, api, "ovh_dns_record", test.quals).List(); err != nil {
+				t.Fatal(err)
+			}
+			if requests := api.Requests(); len(requests) == 0 || requests[0] != test.uri {
+				t.Errorf("got the requests %v, expected %s first", requests, test.uri)
+			}
+		})
+	}
+}
+
+func TestDNSZoneHistoryByDate(t *testing.T) {
+	api := newFakeAPI(t)
+	quals := testQuals{"zone_name": "example.com", "creation_date": operatorQual{">=", time.Date(2026, 9, 1, 0, 0, 0, 0, time.UTC)}}
+	if _, err := newTestQuery(t, api, "ovh_dns_zone_history", quals).List(); err != nil {
+		t.Fatal(err)
+	}
+	uri := "/1.0/domain/zone/example.com/history?creationDate.from=2026-09-01T00%3A00%3A00Z"
+	if requests := api.Requests(); len(requests) == 0 || requests[0] != uri {
+		t.Errorf("got the requests %v, expected %s first", requests, uri)
+	}
+}
+
+func TestIPFilter(t *testing.T) {
+	tests := []struct {
+		name  string
+		quals testQuals
+		uri   string
+	}{
+		{"type", testQuals{"type": "failover"}, "/1.0/ip?type=failover"},
+		{"routed to", testQuals{"routed_to": "ns3000000.ip-192-0-2.eu"}, "/1.0/ip?routedTo.serviceName=ns3000000.ip-192-0-2.eu"},
+		{"tags and type", testQuals{"tags": operatorQual{"?", "team"}, "type": "failover"}, "/1.0/ip?iamTags=" + url.QueryEscape(`{"team":[{"operator":"EXISTS"}]}`) + "&type=failover"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			api := newFakeAPI(t)
+			if _, err := newTestQuery(t, api, "ovh_ip", test.quals).List(); err != nil {
+				t.Fatal(err)
+			}
+			if requests := api.Requests(); len(requests) == 0 || requests[0] != test.uri {
+				t.Errorf("got the requests %v, expected %s first", requests, test.uri)
+			}
+		})
+	}
+}
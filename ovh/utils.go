@@ -2,25 +2,40 @@ package ovh
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/ovh/go-ovh/ovh"
 	"github.com/turbot/steampipe-plugin-sdk/v6/plugin"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 )
 
+// connect returns the client of the connection. The SDK waits for the rate
+// limiters of a hydrate call before calling it, see connectList for the list
+// calls which send many requests.
 func connect(ctx context.Context, d *plugin.QueryData) (*ovh.Client, error) {
-	// get ovh client from cache
-	cacheKey := "ovh"
-	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
-		return cachedData.(*ovh.Client), nil
-	}
-
 	applicationKey := ""
 	applicationSecret := ""
 	consumerKey := ""
+	clientId := ""
+	clientSecret := ""
 	endpoint := ""
 
-	ovhConfig := GetConfig(d.Connection)
+	ovhConfig, err := connectionConfig(d)
+	if err != nil {
+		return nil, err
+	}
 
 	if ovhConfig.ApplicationKey != nil {
 		applicationKey = *ovhConfig.ApplicationKey
@@ -31,32 +46,282 @@ func connect(ctx context.Context, d *plugin.QueryData) (*ovh.Client, error) {
 	if ovhConfig.ConsumerKey != nil {
 		consumerKey = *ovhConfig.ConsumerKey
 	}
+	if ovhConfig.ClientID != nil {
+		clientId = *ovhConfig.ClientID
+	}
+	if ovhConfig.ClientSecret != nil {
+		clientSecret = *ovhConfig.ClientSecret
+	}
 	if ovhConfig.Endpoint != nil {
 		endpoint = *ovhConfig.Endpoint
 	}
 
-	if applicationKey == "" {
-		return nil, errors.New("'application_key' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
-	}
-	if applicationSecret == "" {
-		return nil, errors.New("'application_secret' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	useApplicationKey := applicationKey != "" || applicationSecret != "" || consumerKey != ""
+	useOAuth2 := clientId != "" || clientSecret != ""
+
+	if useApplicationKey && useOAuth2 {
+		return nil, errors.New("'application_key', 'application_secret' and 'consumer_key' cannot be used with 'client_id' and 'client_secret' in the connection configuration, choose one authentication method. Edit your connection configuration file and then restart Steampipe")
 	}
-	if consumerKey == "" {
-		return nil, errors.New("'consumer_key' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+	if useOAuth2 {
+		if clientId == "" {
+			return nil, errors.New("'client_id' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		}
+		if clientSecret == "" {
+			return nil, errors.New("'client_secret' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		}
+	} else {
+		if applicationKey == "" {
+			return nil, errors.New("'application_key' (or 'client_id') must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		}
+		if applicationSecret == "" {
+			return nil, errors.New("'application_secret' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		}
+		if consumerKey == "" {
+			return nil, errors.New("'consumer_key' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
+		}
 	}
 	if endpoint == "" {
 		return nil, errors.New("'endpoint' must be set in the connection configuration. Edit your connection configuration file and then restart Steampipe")
 	}
+	// A full URL can be given instead of an endpoint name
+	if strings.Contains(endpoint, "/") {
+		endpoint = strings.TrimSuffix(endpoint, "/")
+		if u, err := url.Parse(endpoint); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("'endpoint' must be an endpoint name or an http(s) URL, got '%s'. Edit your connection configuration file and then restart Steampipe", endpoint)
+		}
+	}
 
-	client, _ := ovh.NewClient(
-		endpoint,
-		applicationKey,
-		applicationSecret,
-		consumerKey,
-	)
+	proxyUrl := ""
+	caBundle := ""
+	timeout := 0
+	if ovhConfig.ProxyURL != nil {
+		proxyUrl = *ovhConfig.ProxyURL
+	}
+	if ovhConfig.CABundle != nil {
+		caBundle = *ovhConfig.CABundle
+	}
+	if ovhConfig.Timeout != nil {
+		timeout = *ovhConfig.Timeout
+	}
+	if timeout < 0 {
+		return nil, errors.New("'timeout' must be a positive number of seconds. Edit your connection configuration file and then restart Steampipe")
+	}
+	maxRetries := defaultMaxRetries
+	if ovhConfig.MaxRetries != nil {
+		maxRetries = *ovhConfig.MaxRetries
+	}
+	if maxRetries < 0 {
+		return nil, errors.New("'max_retries' must be a positive number. Edit your connection configuration file and then restart Steampipe")
+	}
+
+	// get ovh client from cache
+	cacheKey := clientCacheKey(d.Connection.Name, endpoint, applicationKey, applicationSecret, consumerKey, clientId, clientSecret, proxyUrl, caBundle, strconv.Itoa(timeout), strconv.Itoa(maxRetries))
+	if cachedData, ok := d.ConnectionManager.Cache.Get(cacheKey); ok {
+		return copyClient(cachedData.(*ovh.Client)), nil
+	}
+
+	// The client is built here rather than with ovh.NewClient or
+	// ovh.NewOAuth2Client, which read the OVH_* environment variables and the
+	// ovh.conf files again and fail when they define other credentials than
+	// the ones picked by resolveConfig
+	endpointUrl := endpoint
+	if !strings.Contains(endpoint, "/") {
+		endpointUrl = ovh.Endpoints[endpoint]
+		if endpointUrl == "" {
+			return nil, fmt.Errorf("unknown 'endpoint' '%s'. Edit your connection configuration file and then restart Steampipe", endpoint)
+		}
+	}
+	httpClient, err := newHTTPClient(proxyUrl, caBundle)
+	if err != nil {
+		return nil, err
+	}
+	client := &ovh.Client{
+		Client:  httpClient,
+		Timeout: ovh.DefaultTimeout,
+	}
+	if useOAuth2 {
+		plugin.Logger(ctx).Debug("connect", "auth_method", "oauth2", "client_id", clientId, "scope", oauth2Scope)
+		tokenUrl, ok := oauth2TokenURLs[endpointUrl]
+		if !ok {
+			return nil, fmt.Errorf("OAuth2 authentication is not available on the endpoint '%s', use an application key. Edit your connection configuration file and then restart Steampipe", endpoint)
+		}
+		credentials := &clientcredentials.Config{
+			ClientID:     clientId,
+			ClientSecret: clientSecret,
+			TokenURL:     tokenUrl,
+			Scopes:       []string{oauth2Scope},
+		}
+		// Tokens are requested through the proxy and CA bundle of the connection
+		tokenCtx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: httpClient.Transport})
+		httpClient.Transport = &oauth2.Transport{
+			Source: credentials.TokenSource(tokenCtx),
+			Base:   httpClient.Transport,
+		}
+	} else {
+		plugin.Logger(ctx).Debug("connect", "auth_method", "application_key", "application_key", applicationKey)
+		client.AppKey = applicationKey
+		client.AppSecret = applicationSecret
+		client.ConsumerKey = consumerKey
+	}
+	if err := client.SetEndpoint(endpointUrl); err != nil {
+		return nil, fmt.Errorf("invalid connection configuration: %w. Edit your connection configuration file and then restart Steampipe", err)
+	}
+
+	httpClient.Transport = &retryTransport{
+		next:        httpClient.Transport,
+		maxRetries:  maxRetries,
+		appSecret:   applicationSecret,
+		consumerKey: consumerKey,
+	}
+	if timeout > 0 {
+		client.Timeout = time.Duration(timeout) * time.Second
+	}
+
+	// The copies of the cached client share its time delta with the API, get
+	// it before caching the client so they don't ask for it on every request
+	if client.AppKey != "" {
+		if _, err := client.TimeDelta(); err != nil {
+			return nil, err
+		}
+	}
 
 	// Save to cache
 	d.ConnectionManager.Cache.Set(cacheKey, client)
 
-	return client, nil
+	return copyClient(client), nil
+}
+
+// copyClient returns a copy of client with its own HTTP client. go-ovh sets
+// the timeout of the HTTP client before every request, so goroutines sharing
+// a client would race on it. The copies share the transport of client, with
+// its connections and retries.
+func copyClient(client *ovh.Client) *ovh.Client {
+	clientCopy := *client
+	httpClient := *client.Client
+	clientCopy.Client = &httpClient
+	return &clientCopy
+}
+
+// newHTTPClient returns the HTTP client used to call the OVH API, going
+// through the given proxy (or the one from HTTPS_PROXY) and trusting the
+// certificates of the given CA bundle in addition to the system ones.
+func newHTTPClient(proxyUrl string, caBundle string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	if proxyUrl != "" {
+		u, err := url.Parse(proxyUrl)
+		if err != nil {
+			return nil, fmt.Errorf("'proxy_url' is not a valid URL: %w. Edit your connection configuration file and then restart Steampipe", err)
+		}
+		transport.Proxy = http.ProxyURL(u)
+	}
+
+	if caBundle != "" {
+		pem, err := os.ReadFile(caBundle)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read 'ca_bundle': %w. Edit your connection configuration file and then restart Steampipe", err)
+		}
+		rootCAs, err := x509.SystemCertPool()
+		if err != nil {
+			rootCAs = x509.NewCertPool()
+		}
+		if !rootCAs.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("'ca_bundle' %s does not contain any PEM certificate. Edit your connection configuration file and then restart Steampipe", caBundle)
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: rootCAs}
+	}
+
+	return &http.Client{Transport: transport}, nil
+}
+
+// Scope of the OAuth2 tokens requested for service accounts. It cannot be
+// configured, the rights of a service account are only given by its IAM
+// policies (see docs/index.md).
+const oauth2Scope = "all"
+
+// URLs of the OAuth2 token endpoints, by API endpoint. They are the same as
+// the ones of go-ovh, which does not export them.
+var oauth2TokenURLs = map[string]string{
+	ovh.OvhEU: "https://www.ovh.com/auth/oauth2/token",
+	ovh.OvhCA: "https://ca.ovh.com/auth/oauth2/token",
+	ovh.OvhUS: "https://us.ovhcloud.com/auth/oauth2/token",
+}
+
+// clientCacheKey returns the key of the cached client of a connection. The
+// endpoint and credentials are part of the key so connections never share a
+// client, and a client is not reused once the connection config has changed.
+func clientCacheKey(connectionName string, settings ...string) string {
+	h := sha256.New()
+	for _, setting := range settings {
+		h.Write([]byte(setting))
+		h.Write([]byte{0})
+	}
+	return fmt.Sprintf("ovh-%s-%x", connectionName, h.Sum(nil))
+}
+
+// rowsRemaining returns the number of rows still required by the query.
+func rowsRemaining(ctx context.Context, d *plugin.QueryData) int64 {
+	return limitsOf(ctx, d).RowsRemaining(ctx)
+}
+
+// Maximum number of cloud projects listed in parallel when no project_id is given.
+const projectConcurrency = 5
+
+// forEachProject calls listFunc for the project given in the project_id qual,
+// or for every cloud project of the account when the qual is absent.
+// Projects are processed concurrently, and the first error is returned. The
+// projects removed, expired or suspended while being listed are skipped.
+// Each call gets its own copy of the client, see copyClient.
+func forEachProject(ctx context.Context, d *plugin.QueryData, client *ovh.Client, listFunc func(client *ovh.Client, projectId string) error) error {
+	var projectIds []string
+	if projectId := d.EqualsQuals["project_id"].GetStringValue(); projectId != "" {
+		projectIds = []string{projectId}
+	} else {
+		var err error
+		projectIds, err = listProjectIds(client)
+		if err != nil {
+			return err
+		}
+	}
+
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	semaphore := make(chan struct{}, projectConcurrency)
+
+	for _, projectId := range projectIds {
+		if ctx.Err() != nil || rowsRemaining(ctx, d) == 0 {
+			break
+		}
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+			if err := listFunc(copyClient(client), projectId); err != nil && !ShouldIgnoreError(ctx, d, nil, err) {
+				once.Do(func() { firstErr = err })
+			}
+		}()
+	}
+	wg.Wait()
+
+	return firstErr
+}
+
+// Status code of the OVH API for the services which are expired.
+const statusServiceExpired = 460
+
+// ShouldIgnoreError ignores the errors of the resources which do not exist,
+// e.g. deleted between their listing and their hydration, and of the services
+// which are expired or suspended.
+func ShouldIgnoreError(_ context.Context, _ *plugin.QueryData, _ *plugin.HydrateData, err error) bool {
+	var apiErr *ovh.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code == http.StatusNotFound || apiErr.Code == statusServiceExpired {
+		return true
+	}
+	message := strings.ToLower(apiErr.Message)
+	return strings.Contains(message, "service is expired") || strings.Contains(message, "service is suspended")
 }
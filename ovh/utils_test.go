@@ -0,0 +1,31 @@
+package ovh
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/ovh/go-ovh/ovh"
+)
+
+func TestShouldIgnoreError(t *testing.T) {
+	tests := []struct {
+		err    error
+		ignore bool
+	}{
+		{&ovh.APIError{Code: 404, Class: "Client::NotFound", Message: "The requested object (id = x) does not exist"}, true},
+		{fmt.Errorf("wrapped: %w", &ovh.APIError{Code: 404}), true},
+		{&ovh.APIError{Code: 460, Message: "This service is expired"}, true},
+		{&ovh.APIError{Code: 403, Message: "This service is suspended"}, true},
+		{&ovh.APIError{Code: 403, Message: "This call has not been granted"}, false},
+		{&ovh.APIError{Code: 500, Message: "Internal server error"}, false},
+		{errors.New("connection refused"), false},
+	}
+
+	for _, test := range tests {
+		if ignore := ShouldIgnoreError(context.Background(), nil, nil, test.err); ignore != test.ignore {
+			t.Errorf("ShouldIgnoreError(%v) = %v, expected %v", test.err, ignore, test.ignore)
+		}
+	}
+}